@@ -0,0 +1,275 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"reflect"
+)
+
+// UUIDs is a slice of UUIDs which is stored as a PostgreSQL uuid[] array:
+//
+//	db.Query("SELECT * FROM t WHERE id = ANY($1::uuid[])", uuid.UUIDs(ids))
+//
+// A nil slice is stored as NULL. NULL elements cannot be scanned into UUIDs,
+// use NullUUIDs for arrays which may contain them.
+type UUIDs []UUID
+
+// NullUUIDs is a slice of potentially null UUIDs which is stored as a
+// PostgreSQL uuid[] array, see UUIDs.
+type NullUUIDs []NullUUID
+
+// Scan scans a PostgreSQL array literal of UUIDs like
+// {a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11,"c56a4180-65aa-42ec-a945-5fd21dec0538"}
+// from a string or []byte, reusing the capacity of the slice. NULL scans as
+// a nil slice.
+// If scanning fails the state of the slice is undetermined.
+func (a *UUIDs) Scan(val interface{}) error {
+	if val == nil {
+		*a = nil
+
+		return nil
+	}
+
+	s := (*a)[:0]
+
+	// An empty array must not turn into NULL when the slice is nil
+	if s == nil {
+		s = UUIDs{}
+	}
+
+	if str, ok := val.(string); ok {
+		err := parseArray(str, func(start, end int, null bool) error {
+			if null {
+				return &ErrSyntax{start, len(str)}
+			}
+
+			s = append(s, UUID{})
+
+			return s[len(s)-1].SetString(str[start:end])
+		})
+
+		*a = s
+
+		return err
+	}
+	if b, ok := val.([]byte); ok {
+		err := parseArray(b, func(start, end int, null bool) error {
+			if null {
+				return &ErrSyntax{start, len(b)}
+			}
+
+			s = append(s, UUID{})
+
+			return s[len(s)-1].ReadBytes(b[start:end])
+		})
+
+		*a = s
+
+		return err
+	}
+
+	return &ErrInvalidType{reflect.TypeOf(val)}
+}
+
+// Value gives the database driver representation of the UUIDs as a
+// PostgreSQL array literal, or NULL for a nil slice.
+func (a UUIDs) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	b := make([]byte, 0, 2+37*len(a))
+	b = append(b, '{')
+
+	for i, u := range a {
+		if i > 0 {
+			b = append(b, ',')
+		}
+
+		b, _ = u.AppendText(b)
+	}
+
+	return string(append(b, '}')), nil
+}
+
+// Scan scans a PostgreSQL array literal of potentially null UUIDs like
+// {a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11,NULL} from a string or []byte,
+// reusing the capacity of the slice. NULL scans as a nil slice.
+// If scanning fails the state of the slice is undetermined.
+func (a *NullUUIDs) Scan(val interface{}) error {
+	if val == nil {
+		*a = nil
+
+		return nil
+	}
+
+	s := (*a)[:0]
+
+	// An empty array must not turn into NULL when the slice is nil
+	if s == nil {
+		s = NullUUIDs{}
+	}
+
+	if str, ok := val.(string); ok {
+		err := parseArray(str, func(start, end int, null bool) error {
+			s = append(s, NullUUID{})
+
+			if null {
+				return nil
+			}
+
+			s[len(s)-1].Valid = true
+
+			return s[len(s)-1].UUID.SetString(str[start:end])
+		})
+
+		*a = s
+
+		return err
+	}
+	if b, ok := val.([]byte); ok {
+		err := parseArray(b, func(start, end int, null bool) error {
+			s = append(s, NullUUID{})
+
+			if null {
+				return nil
+			}
+
+			s[len(s)-1].Valid = true
+
+			return s[len(s)-1].UUID.ReadBytes(b[start:end])
+		})
+
+		*a = s
+
+		return err
+	}
+
+	return &ErrInvalidType{reflect.TypeOf(val)}
+}
+
+// Value gives the database driver representation of the potentially null
+// UUIDs as a PostgreSQL array literal, or NULL for a nil slice.
+func (a NullUUIDs) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	b := make([]byte, 0, 2+37*len(a))
+	b = append(b, '{')
+
+	for i, n := range a {
+		if i > 0 {
+			b = append(b, ',')
+		}
+
+		if n.Valid {
+			b, _ = n.UUID.AppendText(b)
+		} else {
+			b = append(b, "NULL"...)
+		}
+	}
+
+	return string(append(b, '}')), nil
+}
+
+// parseArray parses a one-dimensional PostgreSQL array literal, calling fn
+// with the bounds of each element and whether it is NULL. Elements may be
+// quoted and surrounded by whitespace, but as UUIDs never need them
+// backslash escapes are rejected. Errors from fn are returned as-is.
+func parseArray[T string | []byte](str T, fn func(start, end int, null bool) error) error {
+	c := len(str)
+
+	if c == 0 || str[0] != '{' {
+		return &ErrSyntax{0, c}
+	}
+	if c < 2 || str[c-1] != '}' {
+		return &ErrSyntax{c - 1, c}
+	}
+
+	/* Position of the closing brace */
+	e := c - 1
+	x := skipSpace(str, 1, e)
+
+	if x == e {
+		/* Empty array */
+		return nil
+	}
+
+	for {
+		x = skipSpace(str, x, e)
+
+		if x < e && str[x] == '"' {
+			x++
+			start := x
+
+			for x < e && str[x] != '"' {
+				if str[x] == '\\' {
+					return &ErrSyntax{x, c}
+				}
+
+				x++
+			}
+
+			if x == e {
+				return &ErrSyntax{x, c}
+			}
+
+			err := fn(start, x, false)
+			if err != nil {
+				return err
+			}
+
+			x++
+		} else {
+			start := x
+
+			for x < e && str[x] != ',' && !isSpace(str[x]) {
+				if str[x] == '{' || str[x] == '}' || str[x] == '"' || str[x] == '\\' {
+					return &ErrSyntax{x, c}
+				}
+
+				x++
+			}
+
+			if x == start {
+				return &ErrSyntax{x, c}
+			}
+
+			null := x-start == 4 &&
+				toLower(str[start]) == 'n' && toLower(str[start+1]) == 'u' &&
+				toLower(str[start+2]) == 'l' && toLower(str[start+3]) == 'l'
+
+			err := fn(start, x, null)
+			if err != nil {
+				return err
+			}
+		}
+
+		x = skipSpace(str, x, e)
+
+		if x == e {
+			return nil
+		}
+		if str[x] != ',' {
+			return &ErrSyntax{x, c}
+		}
+
+		x++
+	}
+}
+
+// skipSpace returns the position of the first non-whitespace character in
+// str starting at x, or end if there is none before it.
+func skipSpace[T string | []byte](str T, x, end int) int {
+	for x < end && isSpace(str[x]) {
+		x++
+	}
+
+	return x
+}
+
+// isSpace returns true for the whitespace characters PostgreSQL allows
+// around array elements.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
@@ -0,0 +1,215 @@
+package uuid
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testArray = "{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11,c56a4180-65aa-42ec-a945-5fd21dec0538}"
+
+func TestUUIDsScan(t *testing.T) {
+	expected := UUIDs{
+		MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"),
+		MustFromString("c56a4180-65aa-42ec-a945-5fd21dec0538"),
+	}
+
+	list := []string{
+		testArray,
+		`{"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11","c56a4180-65aa-42ec-a945-5fd21dec0538"}`,
+		`{ a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11 , "c56a4180-65aa-42ec-a945-5fd21dec0538" }`,
+		"{A0EEBC99-9C0B-4EF8-BB6D-6BB9BD380A11,C56A4180-65AA-42EC-A945-5FD21DEC0538}",
+	}
+
+	for _, v := range list {
+		for _, val := range []interface{}{v, []byte(v)} {
+			a := UUIDs{}
+
+			err := a.Scan(val)
+			if err != nil {
+				t.Errorf("UUIDs.Scan(%s) failed: %s", v, err.Error())
+			} else if !reflect.DeepEqual(a, expected) {
+				t.Errorf("UUIDs.Scan(%s) returned %v, expected %v", v, a, expected)
+			}
+		}
+	}
+}
+
+func TestUUIDsScanEmpty(t *testing.T) {
+	a := UUIDs{MustFromString(testStringUUID)}
+
+	err := a.Scan("{}")
+	if err != nil {
+		t.Fatalf("UUIDs.Scan({}) failed: %s", err.Error())
+	}
+
+	if a == nil || len(a) != 0 {
+		t.Errorf("UUIDs.Scan({}) returned %#v, expected empty slice", a)
+	}
+
+	err = a.Scan(nil)
+	if err != nil {
+		t.Fatalf("UUIDs.Scan(nil) failed: %s", err.Error())
+	}
+
+	if a != nil {
+		t.Errorf("UUIDs.Scan(nil) returned %#v, expected nil", a)
+	}
+
+	/* Scanning {} into a nil slice must not give NULL back */
+	for _, val := range []interface{}{"{}", []byte("{ }")} {
+		var a UUIDs
+		var n NullUUIDs
+
+		if err := a.Scan(val); err != nil || a == nil {
+			t.Errorf("UUIDs.Scan(%s) into nil slice returned %#v, %v, expected empty slice", val, a, err)
+		}
+
+		if v, _ := a.Value(); v != "{}" {
+			t.Errorf("UUIDs.Value() after Scan(%s) returned %v, expected {}", val, v)
+		}
+
+		if err := n.Scan(val); err != nil || n == nil {
+			t.Errorf("NullUUIDs.Scan(%s) into nil slice returned %#v, %v, expected empty slice", val, n, err)
+		}
+
+		if v, _ := n.Value(); v != "{}" {
+			t.Errorf("NullUUIDs.Value() after Scan(%s) returned %v, expected {}", val, v)
+		}
+	}
+}
+
+func TestUUIDsScanInvalid(t *testing.T) {
+	list := map[string]int{
+		"":                                      0,
+		"{":                                     0,
+		"}":                                     0,
+		"[]":                                    0,
+		"{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11": 36,
+		"{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11,}": 38,
+		"{,a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11}": 1,
+		"{NULL}": 1,
+		"{{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11}}":                                    1,
+		`{"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11}`:                                     38,
+		`{"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11\"}`:                                   38,
+		"{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11 c56a4180-65aa-42ec-a945-5fd21dec0538}": 38,
+	}
+
+	for v, p := range list {
+		for _, val := range []interface{}{v, []byte(v)} {
+			a := UUIDs{}
+
+			err := a.Scan(val)
+			if e, ok := err.(*ErrSyntax); !ok {
+				t.Errorf("UUIDs.Scan(%s) expected ErrSyntax, got %v", v, err)
+			} else if e.Position != p {
+				t.Errorf("UUIDs.Scan(%s) expected error at position %d, got %d", v, p, e.Position)
+			}
+		}
+	}
+
+	a := UUIDs{}
+
+	if _, ok := a.Scan("{a0eebc99-9c0b-4ef8-bb6d}").(*ErrTooShort); !ok {
+		t.Errorf("UUIDs.Scan() expected ErrTooShort on short UUID")
+	}
+
+	if _, ok := a.Scan(12345).(*ErrInvalidType); !ok {
+		t.Errorf("UUIDs.Scan() expected ErrInvalidType on integer")
+	}
+}
+
+func TestUUIDsValue(t *testing.T) {
+	list := []struct {
+		Value    UUIDs
+		Expected interface{}
+	}{
+		{nil, nil},
+		{UUIDs{}, "{}"},
+		{UUIDs{MustFromString(testStringUUID)}, "{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11}"},
+		{UUIDs{MustFromString(testStringUUID), MustFromString("c56a4180-65aa-42ec-a945-5fd21dec0538")}, testArray},
+	}
+
+	for _, v := range list {
+		val, err := v.Value.Value()
+		if err != nil {
+			t.Errorf("UUIDs.Value() failed: %s", err.Error())
+		} else if val != v.Expected {
+			t.Errorf("UUIDs.Value() returned %v, expected %v", val, v.Expected)
+		}
+	}
+}
+
+func TestNullUUIDsScan(t *testing.T) {
+	expected := NullUUIDs{
+		{Valid: true, UUID: MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")},
+		{},
+		{Valid: true, UUID: MustFromString("c56a4180-65aa-42ec-a945-5fd21dec0538")},
+	}
+
+	list := []string{
+		"{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11,NULL,c56a4180-65aa-42ec-a945-5fd21dec0538}",
+		`{"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11",null,"c56a4180-65aa-42ec-a945-5fd21dec0538"}`,
+	}
+
+	for _, v := range list {
+		for _, val := range []interface{}{v, []byte(v)} {
+			a := NullUUIDs{}
+
+			err := a.Scan(val)
+			if err != nil {
+				t.Errorf("NullUUIDs.Scan(%s) failed: %s", v, err.Error())
+			} else if !reflect.DeepEqual(a, expected) {
+				t.Errorf("NullUUIDs.Scan(%s) returned %v, expected %v", v, a, expected)
+			}
+		}
+	}
+
+	/* A quoted NULL is a string, not null */
+	a := NullUUIDs{}
+
+	if err := a.Scan(`{"NULL"}`); err == nil {
+		t.Errorf("NullUUIDs.Scan() did not fail on quoted NULL")
+	}
+
+	if err := a.Scan(nil); err != nil || a != nil {
+		t.Errorf("NullUUIDs.Scan(nil) returned %#v, %v, expected nil", a, err)
+	}
+}
+
+func TestNullUUIDsValue(t *testing.T) {
+	a := NullUUIDs{
+		{Valid: true, UUID: MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")},
+		{UUID: MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")},
+	}
+
+	v, err := a.Value()
+	if err != nil {
+		t.Fatalf("NullUUIDs.Value() failed: %s", err.Error())
+	}
+
+	if v != "{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11,NULL}" {
+		t.Errorf("NullUUIDs.Value() returned %v, expected {a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11,NULL}", v)
+	}
+
+	v, err = NullUUIDs(nil).Value()
+	if err != nil || v != nil {
+		t.Errorf("NullUUIDs.Value() on nil returned %v, %v, expected nil", v, err)
+	}
+}
+
+func BenchmarkUUIDsScan(b *testing.B) {
+	a := UUIDs{}
+	val := interface{}([]byte(testArray))
+
+	for i := 0; i < b.N; i++ {
+		a.Scan(val)
+	}
+}
+
+func BenchmarkUUIDsValue(b *testing.B) {
+	a := UUIDs{MustFromString(testStringUUID), MustFromString("c56a4180-65aa-42ec-a945-5fd21dec0538")}
+
+	for i := 0; i < b.N; i++ {
+		a.Value()
+	}
+}
@@ -0,0 +1,51 @@
+package uuid
+
+import (
+	"bytes"
+	"sort"
+)
+
+// Compare returns an integer comparing two UUIDs byte by byte, -1 if a < b,
+// 0 if a == b and +1 if a > b. It can be used with slices.SortFunc().
+//
+// This is the same ordering as the uuid type of PostgreSQL, and since V6 and
+// V7 UUIDs start with their timestamp it also orders them by time.
+func Compare(a, b UUID) int {
+	return bytes.Compare(a[:], b[:])
+}
+
+// Less reports whether the UUID sorts before v, see Compare().
+func (u UUID) Less(v UUID) bool {
+	return Compare(u, v) < 0
+}
+
+// Sort sorts a slice of UUIDs in increasing order, see Compare().
+func Sort(s []UUID) {
+	sort.Sort(UUIDs(s))
+}
+
+// Search searches for u in a slice sorted in increasing order, returning the
+// position where it is found or would be inserted, and whether it was found.
+func Search(s []UUID, u UUID) (int, bool) {
+	i := sort.Search(len(s), func(i int) bool {
+		return Compare(s[i], u) >= 0
+	})
+
+	return i, i < len(s) && s[i] == u
+}
+
+// Len is the number of UUIDs in the slice, implementing sort.Interface.
+func (a UUIDs) Len() int {
+	return len(a)
+}
+
+// Less reports whether the UUID at i sorts before the one at j,
+// implementing sort.Interface.
+func (a UUIDs) Less(i, j int) bool {
+	return Compare(a[i], a[j]) < 0
+}
+
+// Swap swaps the UUIDs at i and j, implementing sort.Interface.
+func (a UUIDs) Swap(i, j int) {
+	a[i], a[j] = a[j], a[i]
+}
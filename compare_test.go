@@ -0,0 +1,118 @@
+package uuid
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+	list := []struct {
+		A, B     string
+		Expected int
+	}{
+		{"00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000", 0},
+		{"00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000001", -1},
+		{"00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000000", 1},
+		{"0fffffff-ffff-ffff-ffff-ffffffffffff", "10000000-0000-0000-0000-000000000000", -1},
+		{"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", 0},
+		{"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", "c56a4180-65aa-42ec-a945-5fd21dec0538", -1},
+		{"ffffffff-ffff-ffff-ffff-ffffffffffff", "00000000-0000-0000-0000-000000000000", 1},
+	}
+
+	for _, v := range list {
+		a, b := MustFromString(v.A), MustFromString(v.B)
+
+		if c := Compare(a, b); c != v.Expected {
+			t.Errorf("Compare(%s, %s) returned %d, expected %d", a, b, c, v.Expected)
+		}
+
+		if l := a.Less(b); l != (v.Expected < 0) {
+			t.Errorf("%s.Less(%s) returned %t", a, b, l)
+		}
+	}
+}
+
+func TestCompareV7(t *testing.T) {
+	/* Every call to the clock moves it forward by one millisecond */
+	n := 0
+	g := NewGenerator(nil, func() time.Time {
+		n++
+
+		return testRFCTime.Add(time.Duration(n) * time.Millisecond)
+	})
+	s := make([]UUID, 100)
+
+	for i := range s {
+		u, err := g.NewV7()
+		if err != nil {
+			t.Fatalf("NewV7() failed: %s", err.Error())
+		}
+
+		s[i] = u
+	}
+
+	for i := 1; i < len(s); i++ {
+		if !s[i-1].Less(s[i]) {
+			t.Errorf("V7 UUID %s created before %s does not sort before it", s[i-1], s[i])
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	s := make([]UUID, 100)
+
+	if err := V4Batch(s); err != nil {
+		t.Fatalf("V4Batch() failed: %s", err.Error())
+	}
+
+	rand.Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+
+	Sort(s)
+
+	if !sort.SliceIsSorted(s, func(i, j int) bool { return s[i].Less(s[j]) }) {
+		t.Error("Sort() did not sort the slice")
+	}
+}
+
+func TestSearch(t *testing.T) {
+	s := []UUID{
+		MustFromString("10000000-0000-0000-0000-000000000000"),
+		MustFromString("20000000-0000-0000-0000-000000000000"),
+		MustFromString("30000000-0000-0000-0000-000000000000"),
+	}
+
+	list := []struct {
+		UUID  string
+		Index int
+		Found bool
+	}{
+		{"00000000-0000-0000-0000-000000000000", 0, false},
+		{"10000000-0000-0000-0000-000000000000", 0, true},
+		{"10000000-0000-0000-0000-000000000001", 1, false},
+		{"20000000-0000-0000-0000-000000000000", 1, true},
+		{"30000000-0000-0000-0000-000000000000", 2, true},
+		{"ffffffff-ffff-ffff-ffff-ffffffffffff", 3, false},
+	}
+
+	for _, v := range list {
+		i, ok := Search(s, MustFromString(v.UUID))
+		if i != v.Index || ok != v.Found {
+			t.Errorf("Search(%s) returned %d, %t, expected %d, %t", v.UUID, i, ok, v.Index, v.Found)
+		}
+	}
+
+	if i, ok := Search(nil, MustFromString(testStringUUID)); i != 0 || ok {
+		t.Errorf("Search() on empty slice returned %d, %t", i, ok)
+	}
+}
+
+func BenchmarkCompare(b *testing.B) {
+	x := MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+	y := MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a12")
+
+	for i := 0; i < b.N; i++ {
+		Compare(x, y)
+	}
+}
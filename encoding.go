@@ -0,0 +1,270 @@
+package uuid
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"math/bits"
+	"strings"
+)
+
+// ErrOverflow occurs when a string in one of the compact encodings
+// represents a number which does not fit in the 128 bits of a UUID.
+type ErrOverflow struct{}
+
+func (e ErrOverflow) Error() string {
+	return "invalid UUID: encoded value overflows 128 bits"
+}
+
+// Alphabets of the compact encodings, all except base64url are in ASCII order
+// so that fixed-width encoded UUIDs sort in the same order as the UUIDs.
+const (
+	base32Alphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// Fixed lengths of the compact encodings of a UUID.
+const (
+	base32Length    = 26
+	base58Length    = 22
+	base62Length    = 22
+	base64URLLength = 22
+)
+
+// Decoding tables for the compact encodings, 255 for invalid characters.
+var (
+	base32Table = decodeTable(base32Alphabet, true)
+	base58Table = decodeTable(base58Alphabet, false)
+	base62Table = decodeTable(base62Alphabet, false)
+)
+
+// decodeTable creates a table of the value of each character in alphabet,
+// 255 if it is not part of it. If caseless is true lower case letters decode
+// to the same value as their upper case counterpart.
+func decodeTable(alphabet string, caseless bool) *[256]byte {
+	t := [256]byte{}
+
+	for i := range t {
+		t[i] = 255
+	}
+
+	for i := 0; i < len(alphabet); i++ {
+		t[alphabet[i]] = byte(i)
+
+		if caseless && 'A' <= alphabet[i] && alphabet[i] <= 'Z' {
+			t[alphabet[i]+'a'-'A'] = byte(i)
+		}
+	}
+
+	return &t
+}
+
+// Base32 returns the 26 character Crockford base32 encoding of the UUID.
+func (u UUID) Base32() string {
+	b := [base32Length]byte{}
+
+	return string(u.AppendBase32(b[:0]))
+}
+
+// AppendBase32 appends the 26 character Crockford base32 encoding of the
+// UUID to dst and returns the extended buffer.
+func (u UUID) AppendBase32(dst []byte) []byte {
+	return appendBase(dst, u, base32Alphabet, base32Length)
+}
+
+// FromBase32 reads a 26 character Crockford base32 encoded UUID into a new
+// UUID instance. Letters are accepted in any case, but the substitutions of
+// I, L and O allowed by Crockford are not, as they are not part of the
+// alphabet. Since 26 characters hold 130 bits the first character must be
+// at most 7, or ErrOverflow is returned.
+func FromBase32(str string) (UUID, error) {
+	return decodeBase(str, base32Table, 32, base32Length)
+}
+
+// Base58 returns the 22 character base58 encoding of the UUID, using the
+// Bitcoin alphabet and padded with leading '1' characters.
+func (u UUID) Base58() string {
+	b := [base58Length]byte{}
+
+	return string(u.AppendBase58(b[:0]))
+}
+
+// AppendBase58 appends the 22 character base58 encoding of the UUID to dst
+// and returns the extended buffer.
+func (u UUID) AppendBase58(dst []byte) []byte {
+	return appendBase(dst, u, base58Alphabet, base58Length)
+}
+
+// FromBase58 reads a 22 character base58 encoded UUID into a new UUID
+// instance. Values larger than 128 bits result in ErrOverflow.
+func FromBase58(str string) (UUID, error) {
+	return decodeBase(str, base58Table, 58, base58Length)
+}
+
+// Base62 returns the 22 character base62 encoding of the UUID, using the
+// alphabet 0-9, A-Z, a-z and padded with leading '0' characters.
+func (u UUID) Base62() string {
+	b := [base62Length]byte{}
+
+	return string(u.AppendBase62(b[:0]))
+}
+
+// AppendBase62 appends the 22 character base62 encoding of the UUID to dst
+// and returns the extended buffer.
+func (u UUID) AppendBase62(dst []byte) []byte {
+	return appendBase(dst, u, base62Alphabet, base62Length)
+}
+
+// FromBase62 reads a 22 character base62 encoded UUID into a new UUID
+// instance. Values larger than 128 bits result in ErrOverflow.
+func FromBase62(str string) (UUID, error) {
+	return decodeBase(str, base62Table, 62, base62Length)
+}
+
+// Base64URL returns the 22 character unpadded base64url encoding of the
+// UUID, RFC 4648 section 5. Unlike the other compact encodings the alphabet
+// is not in ASCII order, so the encoded strings do not sort like the UUIDs.
+func (u UUID) Base64URL() string {
+	b := [base64URLLength]byte{}
+
+	return string(u.AppendBase64URL(b[:0]))
+}
+
+// AppendBase64URL appends the 22 character unpadded base64url encoding of
+// the UUID to dst and returns the extended buffer.
+func (u UUID) AppendBase64URL(dst []byte) []byte {
+	n := len(dst)
+	dst = append(dst, make([]byte, base64URLLength)...)
+
+	base64.RawURLEncoding.Encode(dst[n:], u[:])
+
+	return dst
+}
+
+// FromBase64URL reads a 22 character unpadded base64url encoded UUID into a
+// new UUID instance. The unused low bits of the last character must be zero.
+func FromBase64URL(str string) (UUID, error) {
+	u := UUID{}
+
+	if len(str) != base64URLLength {
+		return u, lengthError(len(str), base64URLLength)
+	}
+
+	/* The decoder skips newlines even in strict mode */
+	if i := strings.IndexAny(str, "\r\n"); i >= 0 {
+		return u, &ErrSyntax{i, len(str)}
+	}
+
+	_, err := base64.RawURLEncoding.Strict().Decode(u[:], []byte(str))
+	if e, ok := err.(base64.CorruptInputError); ok {
+		return u, &ErrSyntax{int(e), len(str)}
+	}
+
+	return u, err
+}
+
+// appendBase appends the UUID as a width characters long number in the
+// base of the length of alphabet to dst, padded with leading zeros.
+func appendBase(dst []byte, u UUID, alphabet string, width int) []byte {
+	hi := binary.BigEndian.Uint64(u[:8])
+	lo := binary.BigEndian.Uint64(u[8:])
+	base := uint64(len(alphabet))
+
+	n := len(dst)
+	dst = append(dst, make([]byte, width)...)
+	b := dst[n : n+width]
+
+	for i := width - 1; i >= 0; i-- {
+		var r uint64
+
+		/* 128-bit by 64-bit long division, one 64-bit word at a time */
+		hi, r = bits.Div64(0, hi, base)
+		lo, r = bits.Div64(r, lo, base)
+
+		b[i] = alphabet[r]
+	}
+
+	return dst
+}
+
+// decodeBase reads a width characters long number in the given base into a
+// new UUID instance, using table to decode the characters.
+func decodeBase(str string, table *[256]byte, base uint64, width int) (UUID, error) {
+	u := UUID{}
+
+	if len(str) != width {
+		return u, lengthError(len(str), width)
+	}
+
+	var hi, lo uint64
+
+	for i := 0; i < width; i++ {
+		d := table[str[i]]
+		if d == 255 {
+			return u, &ErrSyntax{i, len(str)}
+		}
+
+		/* (hi, lo) = (hi, lo) * base + d, checking for overflow */
+		c, l := bits.Mul64(lo, base)
+		l, carry := bits.Add64(l, uint64(d), 0)
+		o, h := bits.Mul64(hi, base)
+		h, carry = bits.Add64(h, c+carry, 0)
+
+		if o != 0 || carry != 0 {
+			return u, &ErrOverflow{}
+		}
+
+		hi, lo = h, l
+	}
+
+	binary.BigEndian.PutUint64(u[:8], hi)
+	binary.BigEndian.PutUint64(u[8:], lo)
+
+	return u, nil
+}
+
+// lengthError returns an ErrSyntax for a string of length n where exactly
+// width characters were expected.
+func lengthError(n, width int) error {
+	if n < width {
+		return &ErrSyntax{n, n}
+	}
+
+	return &ErrSyntax{width, n}
+}
+
+// ULID returns the UUID as a ULID, the 26 character Crockford base32
+// encoding also returned by Base32(). ULIDs and V7 UUIDs both start with a
+// 48-bit Unix timestamp in milliseconds, so a V7 UUID gives a ULID with the
+// same timestamp.
+func (u UUID) ULID() string {
+	return u.Base32()
+}
+
+// FromULID reads a ULID into a new UUID instance, keeping all 128 bits as-is.
+// This follows the validation rules of the ULID specification: letters are
+// accepted in any case, I, L, O and U are rejected, and the first character
+// must be at most 7 or ErrOverflow is returned.
+//
+// The result is usually not a valid RFC 9562 UUID, see V7FromULID().
+func FromULID(str string) (UUID, error) {
+	return FromBase32(str)
+}
+
+// V7FromULID reads a ULID into a new V7 UUID with the same timestamp.
+//
+// As the version and variant bits of the UUID are set, 6 of the 80 random
+// bits of the ULID are lost: the 4 high bits of the 7th byte and the 2 high
+// bits of the 9th byte. Converting the UUID back with ULID() will therefore
+// not give the original ULID unless those bits happen to match.
+func V7FromULID(str string) (UUID, error) {
+	u, err := FromULID(str)
+	if err != nil {
+		return u, err
+	}
+
+	u.setVariant()
+	u.setVersion(7)
+
+	return u, nil
+}
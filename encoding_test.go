@@ -0,0 +1,256 @@
+package uuid
+
+import (
+	"sort"
+	"testing"
+)
+
+// testEncodings contains UUIDs and their base32, base58, base62 and base64url
+// encodings.
+var testEncodings = map[string][4]string{
+	"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11": {"50XTY9K70B9VWBPVBBQ6YKG2GH", "LscZPf6gEUNpT5cZL3A9zY", "4tfsGfIEoZU5vMc7aC4vDd", "oO68mZwLTvi7bWu5vTgKEQ"},
+	"00000000-0000-0000-0000-000000000000": {"00000000000000000000000000", "1111111111111111111111", "0000000000000000000000", "AAAAAAAAAAAAAAAAAAAAAA"},
+	"ffffffff-ffff-ffff-ffff-ffffffffffff": {"7ZZZZZZZZZZZZZZZZZZZZZZZZZ", "YcVfxkQb6JRzqk5kF2tNLv", "7n42DGM5Tflk9n8mt7Fhc7", "_____________________w"},
+	"017f22e2-79b0-7cc3-98c4-dc0c0c07398f": {"01FWHE4YDGFK1SHH6W1G60EECF", "1BihbxwwQ4NZZpKRH9JDCz", "02p5oQZoHTv0zeY5yG21K3", "AX8i4nmwfMOYxNwMDAc5jw"},
+}
+
+// testEncoders lists the encoding and decoding functions in the same order
+// as the values in testEncodings.
+var testEncoders = []struct {
+	Name   string
+	Encode func(UUID) string
+	Append func(UUID, []byte) []byte
+	Decode func(string) (UUID, error)
+}{
+	{"Base32", UUID.Base32, UUID.AppendBase32, FromBase32},
+	{"Base58", UUID.Base58, UUID.AppendBase58, FromBase58},
+	{"Base62", UUID.Base62, UUID.AppendBase62, FromBase62},
+	{"Base64URL", UUID.Base64URL, UUID.AppendBase64URL, FromBase64URL},
+}
+
+func TestEncodings(t *testing.T) {
+	for i, v := range testEncodings {
+		u := MustFromString(i)
+
+		for n, e := range testEncoders {
+			if s := e.Encode(u); s != v[n] {
+				t.Errorf("%s(%s) returned '%s', expected '%s'", e.Name, i, s, v[n])
+			}
+
+			if b := e.Append(u, []byte("<")); string(b) != "<"+v[n] {
+				t.Errorf("Append%s(%s) returned '%s', expected '<%s'", e.Name, i, b, v[n])
+			}
+
+			d, err := e.Decode(v[n])
+			if err != nil {
+				t.Errorf("From%s(%s) failed: %s", e.Name, v[n], err.Error())
+			} else if d != u {
+				t.Errorf("From%s(%s) returned '%s', expected '%s'", e.Name, v[n], d.String(), i)
+			}
+		}
+	}
+}
+
+func TestEncodingsRoundTrip(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		u, err := V4()
+		if err != nil {
+			panic(err)
+		}
+
+		for _, e := range testEncoders {
+			d, err := e.Decode(e.Encode(u))
+			if err != nil || d != u {
+				t.Fatalf("From%s(%s()) did not round-trip '%s': %v", e.Name, e.Name, u.String(), err)
+			}
+		}
+	}
+}
+
+func TestEncodingsOrder(t *testing.T) {
+	list := make([]UUID, 1000)
+
+	err := V4Batch(list)
+	if err != nil {
+		panic(err)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return string(list[i][:]) < string(list[j][:])
+	})
+
+	for _, e := range testEncoders[:3] {
+		for i := 1; i < len(list); i++ {
+			if e.Encode(list[i-1]) >= e.Encode(list[i]) {
+				t.Fatalf("%s(%s) does not sort before %s(%s)", e.Name, list[i-1].String(), e.Name, list[i].String())
+			}
+		}
+	}
+}
+
+func TestFromBase32Lower(t *testing.T) {
+	u, err := FromBase32("50xty9k70b9vwbpvbbq6ykg2gh")
+	if err != nil {
+		t.Fatalf("FromBase32() failed on lower case: %s", err.Error())
+	}
+
+	if u.String() != testStringUUID {
+		t.Errorf("FromBase32() returned '%s', expected '%s'", u.String(), testStringUUID)
+	}
+}
+
+func TestEncodingsInvalid(t *testing.T) {
+	list := []struct {
+		Decode   func(string) (UUID, error)
+		Str      string
+		Position int
+	}{
+		{FromBase32, "50XTY9K70B9VWBPVBBQ6YKG2G", 25},
+		{FromBase32, "50XTY9K70B9VWBPVBBQ6YKG2GHH", 26},
+		{FromBase32, "5IXTY9K70B9VWBPVBBQ6YKG2GH", 1},
+		{FromBase32, "50XTY9K70B9VWBPVBBQ6YKG2GL", 25},
+		{FromBase32, "50XTY9K70B9VWBPVBBQ6YKG2GO", 25},
+		{FromBase32, "50XTY9K70B9VWBPVBBQ6YKG2GU", 25},
+		{FromBase58, "LscZPf6gEUNpT5cZL3A9z0", 21},
+		{FromBase58, "LscZPf6gEUNpT5cZL3A9zO", 21},
+		{FromBase58, "LscZPf6gEUNpT5cZL3A9zI", 21},
+		{FromBase58, "LscZPf6gEUNpT5cZL3A9zl", 21},
+		{FromBase58, "", 0},
+		{FromBase62, "4tfsGfIEoZU5vMc7aC4vD-", 21},
+		{FromBase62, "4tfsGfIEoZU5vMc7aC4vDdd", 22},
+		{FromBase64URL, "oO68mZwLTvi7bWu5vTgK+Q", 20},
+		{FromBase64URL, "oO68mZwLTvi7bWu5vTgKEQ==", 22},
+		{FromBase64URL, "_____________________x", 20},
+		{FromBase64URL, "AAAAAAAAAAAAAAAAAAAA\n\n", 20},
+		{FromBase64URL, "AAAAAAAAAA\r\nAAAAAAAAAA", 10},
+	}
+
+	for _, v := range list {
+		_, err := v.Decode(v.Str)
+
+		e, ok := err.(*ErrSyntax)
+		if !ok {
+			t.Errorf("decoding '%s' expected ErrSyntax, got %v", v.Str, err)
+		} else if e.Position != v.Position {
+			t.Errorf("decoding '%s' expected error at position %d, got %d", v.Str, v.Position, e.Position)
+		}
+	}
+}
+
+func TestEncodingsOverflow(t *testing.T) {
+	list := []struct {
+		Decode func(string) (UUID, error)
+		Str    string
+	}{
+		{FromBase32, "80000000000000000000000000"},
+		{FromBase32, "ZZZZZZZZZZZZZZZZZZZZZZZZZZ"},
+		{FromBase58, "YcVfxkQb6JRzqk5kF2tNLw"},
+		{FromBase58, "zzzzzzzzzzzzzzzzzzzzzz"},
+		{FromBase62, "7n42DGM5Tflk9n8mt7Fhc8"},
+		{FromBase62, "zzzzzzzzzzzzzzzzzzzzzz"},
+	}
+
+	for _, v := range list {
+		_, err := v.Decode(v.Str)
+		if _, ok := err.(*ErrOverflow); !ok {
+			t.Errorf("decoding '%s' expected ErrOverflow, got %v", v.Str, err)
+		}
+	}
+}
+
+func TestULID(t *testing.T) {
+	/* Example from the ULID specification */
+	u, err := FromULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	if err != nil {
+		t.Fatalf("FromULID() failed: %s", err.Error())
+	}
+
+	if u.String() != "01563e3a-b5d3-d676-4c61-efb99302bd5b" {
+		t.Errorf("FromULID() returned '%s', expected '01563e3a-b5d3-d676-4c61-efb99302bd5b'", u.String())
+	}
+
+	if u.ULID() != "01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+		t.Errorf("ULID() returned '%s', expected '01ARZ3NDEKTSV4RRFFQ69G5FAV'", u.ULID())
+	}
+
+	u, err = FromULID("01arz3ndektsv4rrffq69g5fav")
+	if err != nil || u.ULID() != "01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+		t.Errorf("FromULID() failed on lower case ULID: %v", err)
+	}
+
+	for _, v := range []string{
+		"01ARZ3NDEKTSV4RRFFQ69G5FAI",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAL",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAO",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAU",
+		"01ARZ3NDEKTSV4RRFFQ69G5FA",
+	} {
+		if _, err := FromULID(v); err == nil {
+			t.Errorf("FromULID(%s) did not fail", v)
+		}
+	}
+
+	if _, err := FromULID("8ZZZZZZZZZZZZZZZZZZZZZZZZZ"); err == nil {
+		t.Errorf("FromULID() did not fail on first character larger than 7")
+	}
+}
+
+func TestV7FromULID(t *testing.T) {
+	u, err := V7FromULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	if err != nil {
+		t.Fatalf("V7FromULID() failed: %s", err.Error())
+	}
+
+	if u.String() != "01563e3a-b5d3-7676-8c61-efb99302bd5b" {
+		t.Errorf("V7FromULID() returned '%s', expected '01563e3a-b5d3-7676-8c61-efb99302bd5b'", u.String())
+	}
+
+	if u.Version() != 7 {
+		t.Errorf("V7FromULID() returned version %d, expected 7", u.Version())
+	}
+
+	tm, err := u.Time()
+	if err != nil || tm.UnixMilli() != 1469922850259 {
+		t.Errorf("V7FromULID() did not keep the ULID timestamp: %v %v", tm, err)
+	}
+
+	if _, err := V7FromULID("01ARZ3NDEKTSV4RRFFQ69G5FAU"); err == nil {
+		t.Errorf("V7FromULID() did not fail on invalid ULID")
+	}
+}
+
+func TestULIDFromV7(t *testing.T) {
+	u, err := V7()
+	if err != nil {
+		panic(err)
+	}
+
+	v, err := V7FromULID(u.ULID())
+	if err != nil || v != u {
+		t.Errorf("V7FromULID(ULID()) did not round-trip V7 UUID '%s'", u.String())
+	}
+}
+
+func BenchmarkBase32(b *testing.B) {
+	u := MustFromString(testStringUUID)
+	buf := make([]byte, 0, 32)
+
+	for i := 0; i < b.N; i++ {
+		u.AppendBase32(buf[:0])
+	}
+}
+
+func BenchmarkBase58(b *testing.B) {
+	u := MustFromString(testStringUUID)
+	buf := make([]byte, 0, 32)
+
+	for i := 0; i < b.N; i++ {
+		u.AppendBase58(buf[:0])
+	}
+}
+
+func BenchmarkFromBase58(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FromBase58("LscZPf6gEUNpT5cZL3A9zY")
+	}
+}
@@ -0,0 +1,184 @@
+package uuid
+
+import (
+	"fmt"
+)
+
+// URN returns the URN form of the UUID, the canonical representation
+// prefixed by "urn:uuid:".
+func (u UUID) URN() string {
+	b := [45]byte{}
+
+	return string(u.AppendURN(b[:0]))
+}
+
+// AppendURN appends the URN form of the UUID to dst and returns the
+// extended buffer.
+func (u UUID) AppendURN(dst []byte) []byte {
+	dst = append(dst, urnPrefix...)
+
+	return appendHex(dst, u, halfbyte2hexchar, true)
+}
+
+// Format implements fmt.Formatter, supporting the following verbs:
+//
+//	%v, %s  canonical form:     a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11
+//	%+v     braced upper case:  {A0EEBC99-9C0B-4EF8-BB6D-6BB9BD380A11}
+//	%#v     Go syntax:          uuid.UUID{0xa0, 0xee, ...}
+//	%x      compact:            a0eebc999c0b4ef8bb6d6bb9bd380a11
+//	%X      compact upper case: A0EEBC999C0B4EF8BB6D6BB9BD380A11
+//	%q      quoted:             "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+//
+// A width pads the result with spaces, on the right if the '-' flag is given.
+func (u UUID) Format(f fmt.State, verb rune) {
+	b := [48]byte{}
+	buf := b[:0]
+
+	switch verb {
+	case 'v':
+		switch {
+		case f.Flag('+'):
+			buf = u.AppendBracedUpper(buf)
+		case f.Flag('#'):
+			/* Reuse the Go syntax of the array, replacing the type */
+			fmt.Fprintf(f, "uuid.UUID%s", fmt.Sprintf("%#v", [16]byte(u))[len("[16]uint8"):])
+
+			return
+		default:
+			buf, _ = u.AppendText(buf)
+		}
+	case 's':
+		buf, _ = u.AppendText(buf)
+	case 'x':
+		buf = u.AppendCompact(buf)
+	case 'X':
+		buf = u.AppendCompactUpper(buf)
+	case 'q':
+		buf = u.AppendJSON(buf)
+	default:
+		fmt.Fprintf(f, "%%!%c(uuid.UUID=%s)", verb, u.String())
+
+		return
+	}
+
+	w, ok := f.Width()
+	if !ok || w <= len(buf) {
+		f.Write(buf)
+
+		return
+	}
+
+	pad := make([]byte, w-len(buf))
+	for i := range pad {
+		pad[i] = ' '
+	}
+
+	if f.Flag('-') {
+		f.Write(buf)
+		f.Write(pad)
+	} else {
+		f.Write(pad)
+		f.Write(buf)
+	}
+}
+
+// AppendText appends the canonical representation of the UUID to b and
+// returns the extended buffer, implementing encoding.TextAppender.
+// The error is always nil.
+func (u UUID) AppendText(b []byte) ([]byte, error) {
+	return appendHex(b, u, halfbyte2hexchar, true), nil
+}
+
+// AppendJSON appends the canonical representation of the UUID as a
+// JSON-string to dst and returns the extended buffer.
+func (u UUID) AppendJSON(dst []byte) []byte {
+	dst = append(dst, '"')
+	dst = appendHex(dst, u, halfbyte2hexchar, true)
+
+	return append(dst, '"')
+}
+
+// AppendUpper appends the upper case canonical representation of the UUID
+// to dst and returns the extended buffer.
+func (u UUID) AppendUpper(dst []byte) []byte {
+	return appendHex(dst, u, halfbyte2hexcharUpper, true)
+}
+
+// AppendCompact appends the 32 hexadecimal digits of the UUID without
+// hyphens to dst and returns the extended buffer.
+func (u UUID) AppendCompact(dst []byte) []byte {
+	return appendHex(dst, u, halfbyte2hexchar, false)
+}
+
+// AppendCompactUpper appends the 32 upper case hexadecimal digits of the
+// UUID without hyphens to dst and returns the extended buffer.
+func (u UUID) AppendCompactUpper(dst []byte) []byte {
+	return appendHex(dst, u, halfbyte2hexcharUpper, false)
+}
+
+// AppendBraced appends the canonical representation of the UUID enclosed in
+// curly braces to dst and returns the extended buffer.
+func (u UUID) AppendBraced(dst []byte) []byte {
+	dst = append(dst, '{')
+	dst = appendHex(dst, u, halfbyte2hexchar, true)
+
+	return append(dst, '}')
+}
+
+// AppendBracedUpper appends the upper case canonical representation of the
+// UUID enclosed in curly braces to dst and returns the extended buffer.
+func (u UUID) AppendBracedUpper(dst []byte) []byte {
+	dst = append(dst, '{')
+	dst = appendHex(dst, u, halfbyte2hexcharUpper, true)
+
+	return append(dst, '}')
+}
+
+// canonicalOffsets contains the position of each byte of the UUID in the
+// canonical representation.
+var canonicalOffsets = [16]int{
+	0, 2, 4, 6,
+	9, 11,
+	14, 16,
+	19, 21,
+	24, 26, 28, 30, 32, 34,
+}
+
+// appendHex appends the hexadecimal representation of the UUID to dst using
+// the characters in table, with hyphens in the canonical positions if
+// hyphens is true.
+//
+// This is the single implementation of the hexadecimal encoding used by all
+// the string-creating methods of UUID.
+func appendHex(dst []byte, u UUID, table []byte, hyphens bool) []byte {
+	/* Growing once and writing by index is about twice as fast as
+	   appending each character */
+	n := len(dst)
+
+	if !hyphens {
+		dst = append(dst, make([]byte, 32)...)
+		b := dst[n : n+32]
+
+		for i := 0; i < 16; i++ {
+			b[2*i] = table[u[i]>>4]
+			b[2*i+1] = table[u[i]&0x0f]
+		}
+
+		return dst
+	}
+
+	dst = append(dst, make([]byte, 36)...)
+	b := dst[n : n+36]
+
+	for i, x := range canonicalOffsets {
+		b[x] = table[u[i]>>4]
+		b[x+1] = table[u[i]&0x0f]
+	}
+
+	b[8] = '-'
+	b[13] = '-'
+	b[18] = '-'
+	b[23] = '-'
+
+	return dst
+}
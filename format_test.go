@@ -0,0 +1,147 @@
+package uuid
+
+import (
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestURN(t *testing.T) {
+	u := MustFromString(testStringUUID)
+
+	if u.URN() != "urn:uuid:"+testStringUUID {
+		t.Errorf("URN() returned '%s', expected 'urn:uuid:%s'", u.URN(), testStringUUID)
+	}
+
+	b := u.AppendURN([]byte("<id>"))
+	if string(b) != "<id>urn:uuid:"+testStringUUID {
+		t.Errorf("AppendURN() returned '%s', expected '<id>urn:uuid:%s'", b, testStringUUID)
+	}
+
+	v, err := ParseURN(u.URN())
+	if err != nil || v != u {
+		t.Errorf("ParseURN(URN()) did not round-trip '%s'", u.URN())
+	}
+}
+
+func TestFormat(t *testing.T) {
+	u := MustFromString(testStringUUID)
+
+	list := map[string]string{
+		"%v":    "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11",
+		"%s":    "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11",
+		"%+v":   "{A0EEBC99-9C0B-4EF8-BB6D-6BB9BD380A11}",
+		"%#v":   "uuid.UUID{0xa0, 0xee, 0xbc, 0x99, 0x9c, 0xb, 0x4e, 0xf8, 0xbb, 0x6d, 0x6b, 0xb9, 0xbd, 0x38, 0xa, 0x11}",
+		"%x":    "a0eebc999c0b4ef8bb6d6bb9bd380a11",
+		"%X":    "A0EEBC999C0B4EF8BB6D6BB9BD380A11",
+		"%q":    `"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"`,
+		"%d":    "%!d(uuid.UUID=a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11)",
+		"%40s":  "    a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11",
+		"%-40s": "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11    ",
+		"%10s":  "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11",
+		"[%x]":  "[a0eebc999c0b4ef8bb6d6bb9bd380a11]",
+	}
+
+	for f, v := range list {
+		s := fmt.Sprintf(f, u)
+
+		if s != v {
+			t.Errorf("Sprintf(%s) returned '%s', expected '%s'", f, s, v)
+		}
+	}
+}
+
+func TestFormatPointer(t *testing.T) {
+	u := MustFromString(testStringUUID)
+
+	if s := fmt.Sprintf("%X", &u); s != "A0EEBC999C0B4EF8BB6D6BB9BD380A11" {
+		t.Errorf("Sprintf(%%X) on *UUID returned '%s'", s)
+	}
+}
+
+// textAppender is encoding.TextAppender from Go 1.24.
+type textAppender interface {
+	AppendText(b []byte) ([]byte, error)
+}
+
+var _ textAppender = UUID{}
+
+func TestAppend(t *testing.T) {
+	u := MustFromString(testStringUUID)
+
+	list := map[string][]byte{
+		"<a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11":          func() []byte { b, _ := u.AppendText([]byte("<")); return b }(),
+		`<"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"`:        u.AppendJSON([]byte("<")),
+		"<A0EEBC99-9C0B-4EF8-BB6D-6BB9BD380A11":          u.AppendUpper([]byte("<")),
+		"<a0eebc999c0b4ef8bb6d6bb9bd380a11":              u.AppendCompact([]byte("<")),
+		"<A0EEBC999C0B4EF8BB6D6BB9BD380A11":              u.AppendCompactUpper([]byte("<")),
+		"<{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11}":        u.AppendBraced([]byte("<")),
+		"<{A0EEBC99-9C0B-4EF8-BB6D-6BB9BD380A11}":        u.AppendBracedUpper([]byte("<")),
+		"<urn:uuid:a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11": u.AppendURN([]byte("<")),
+	}
+
+	for v, b := range list {
+		if string(b) != v {
+			t.Errorf("expected '%s', got '%s'", v, b)
+		}
+	}
+}
+
+func TestAppendAllocs(t *testing.T) {
+	u := MustFromString(testStringUUID)
+	buf := make([]byte, 0, 64)
+
+	n := testing.AllocsPerRun(100, func() {
+		buf, _ = u.AppendText(buf[:0])
+		buf = u.AppendJSON(buf[:0])
+		buf = u.AppendCompactUpper(buf[:0])
+		buf = u.AppendBraced(buf[:0])
+	})
+
+	if n != 0 {
+		t.Errorf("Append methods allocated %f times, expected 0", n)
+	}
+}
+
+func BenchmarkAppendText(b *testing.B) {
+	u := MustFromString(testStringUUID)
+	buf := make([]byte, 0, 64)
+
+	for i := 0; i < b.N; i++ {
+		u.AppendText(buf[:0])
+	}
+}
+
+func BenchmarkAppendJSON(b *testing.B) {
+	u := MustFromString(testStringUUID)
+	buf := make([]byte, 0, 64)
+
+	for i := 0; i < b.N; i++ {
+		u.AppendJSON(buf[:0])
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	u := MustFromString(testStringUUID)
+
+	for i := 0; i < b.N; i++ {
+		fmt.Fprintf(io.Discard, "%X", u)
+	}
+}
+
+func BenchmarkURN(b *testing.B) {
+	u := MustFromString(testStringUUID)
+
+	for i := 0; i < b.N; i++ {
+		_ = u.URN()
+	}
+}
+
+func BenchmarkAppendURN(b *testing.B) {
+	u := MustFromString(testStringUUID)
+	buf := make([]byte, 0, 64)
+
+	for i := 0; i < b.N; i++ {
+		u.AppendURN(buf[:0])
+	}
+}
@@ -0,0 +1,454 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"io"
+	"sync"
+	"time"
+)
+
+// Generator creates UUIDs using a configurable source of randomness and
+// clock. The time-based UUIDs keep their clock sequence, node ID and
+// monotonic state per Generator.
+//
+// The zero value is a Generator using crypto/rand.Reader and time.Now.
+//
+// A Generator is safe for concurrent use as long as its source of randomness
+// is; crypto/rand.Reader is, while a bytes.Reader used for tests is not.
+type Generator struct {
+	// rand is nil for crypto/rand.Reader, reading through rand.Read()
+	// directly avoids the UUID escaping to the heap
+	rand io.Reader
+	// now is nil for time.Now
+	now func() time.Time
+
+	// mu guards the time-based state below
+	mu       sync.Mutex
+	ready    bool
+	last     uint64
+	clockSeq uint16
+	node     [6]byte
+	hasNode  bool
+	v7Last   UUID
+
+	// v2Time is the part of the timestamp kept in V2 UUIDs which the clock
+	// sequences in v2Seq were used for
+	v2Time uint64
+	v2Seq  map[v2Key]v2State
+}
+
+// v2Key is the domain and local identifier of a V2 UUID.
+type v2Key struct {
+	domain Domain
+	id     uint32
+}
+
+// v2State is the first and the next 6-bit clock sequence of V2 UUIDs for a
+// v2Key, they are equal once all 64 have been used.
+type v2State struct {
+	first byte
+	next  byte
+}
+
+// defaultGenerator is used by the package-level generator functions.
+var defaultGenerator = &Generator{}
+
+// NewGenerator creates a new Generator reading random data from r and the
+// current time from now. If r is nil crypto/rand.Reader is used and if now is
+// nil time.Now is used.
+func NewGenerator(r io.Reader, now func() time.Time) *Generator {
+	if r == rand.Reader {
+		r = nil
+	}
+
+	return &Generator{rand: r, now: now}
+}
+
+// clock returns the current time from the clock of the Generator.
+func (g *Generator) clock() time.Time {
+	if g.now == nil {
+		return time.Now()
+	}
+
+	return g.now()
+}
+
+// read fills b, which is at most 16 bytes, with random data from the source
+// of randomness.
+func (g *Generator) read(b []byte) error {
+	if g.rand == nil {
+		_, err := rand.Read(b)
+
+		return err
+	}
+
+	if br, ok := g.rand.(*bufferedReader); ok {
+		_, err := br.fill(b)
+
+		return err
+	}
+
+	// Reading into a pooled copy keeps b from escaping through the io.Reader
+	// interface, which would make the cases above allocate as well
+	c := readPool.Get().(*[16]byte)
+	defer readPool.Put(c)
+
+	_, err := io.ReadFull(g.rand, c[:len(b)])
+
+	copy(b, c[:])
+
+	return err
+}
+
+// readPool holds the buffers used by Generator.read() for other readers than
+// crypto/rand.Reader and buffered readers.
+var readPool = sync.Pool{
+	New: func() interface{} {
+		return new([16]byte)
+	},
+}
+
+// NewV1 creates a new time-based UUID from the current time, a clock sequence
+// and a node ID. The node ID is random with the multicast bit set unless
+// SetNodeID() has been called.
+func (g *Generator) NewV1() (UUID, error) {
+	u := UUID{}
+
+	t, seq, node, err := g.timeNext()
+	if err != nil {
+		return u, err
+	}
+
+	u.setV1Time(t)
+	u[8] = byte(seq >> 8)
+	u[9] = byte(seq)
+
+	copy(u[10:], node[:])
+
+	u.setVariant()
+	u.setVersion(1)
+
+	return u, nil
+}
+
+// NewV2 creates a new DCE Security UUID like NewV1(), but with the low 32
+// bits of the timestamp replaced by the local identifier id of the given
+// domain, and the low 8 bits of the clock sequence replaced by the domain.
+//
+// As only the 6 high bits of the clock sequence remain, NewV2 can at most
+// create 64 distinct UUIDs for the same domain and identifier within the
+// roughly 7 minutes it takes for the remaining timestamp bits to change,
+// after that ErrV2Exhausted is returned until they do.
+func (g *Generator) NewV2(domain Domain, id uint32) (UUID, error) {
+	u := UUID{}
+
+	t, seq, node, err := g.timeNextV2(domain, id)
+	if err != nil {
+		return u, err
+	}
+
+	u.setV1Time(t)
+	u[0] = byte(id >> 24)
+	u[1] = byte(id >> 16)
+	u[2] = byte(id >> 8)
+	u[3] = byte(id)
+	u[8] = byte(seq >> 8)
+	u[9] = byte(domain)
+
+	copy(u[10:], node[:])
+
+	u.setVariant()
+	u.setVersion(2)
+
+	return u, nil
+}
+
+// NewV4 creates a new random UUID.
+func (g *Generator) NewV4() (UUID, error) {
+	u := UUID{}
+
+	err := g.read(u[:])
+	if err != nil {
+		return u, err
+	}
+
+	u.setVariant()
+	u.setVersion(4)
+
+	return u, nil
+}
+
+// NewV4Batch fills dst with new random UUIDs using a single read from the
+// source of randomness, which is a lot faster than calling NewV4() for each
+// UUID when the source is crypto/rand.Reader.
+func (g *Generator) NewV4Batch(dst []UUID) error {
+	b := make([]byte, 16*len(dst))
+
+	r := g.rand
+	if r == nil {
+		r = rand.Reader
+	}
+
+	_, err := io.ReadFull(r, b)
+	if err != nil {
+		return err
+	}
+
+	for i := range dst {
+		copy(dst[i][:], b[16*i:])
+
+		dst[i].setVariant()
+		dst[i].setVersion(4)
+	}
+
+	return nil
+}
+
+// NewV6 creates a new time-based UUID like NewV1(), but with the timestamp
+// reordered from most to least significant bits to make the UUIDs sort in
+// time order. NewV6 shares clock sequence and node ID with NewV1.
+func (g *Generator) NewV6() (UUID, error) {
+	u := UUID{}
+
+	t, seq, node, err := g.timeNext()
+	if err != nil {
+		return u, err
+	}
+
+	u.setV6Time(t)
+	u[8] = byte(seq >> 8)
+	u[9] = byte(seq)
+
+	copy(u[10:], node[:])
+
+	u.setVariant()
+	u.setVersion(6)
+
+	return u, nil
+}
+
+// NewV7 creates a new time-ordered UUID from the current Unix time in
+// milliseconds followed by random data.
+// UUIDs created within the same millisecond are not ordered, see
+// NewV7Monotonic().
+func (g *Generator) NewV7() (UUID, error) {
+	u := UUID{}
+
+	err := g.read(u[6:])
+	if err != nil {
+		return u, err
+	}
+
+	u.setV7Time(uint64(g.clock().UnixMilli()))
+	u.setVariant()
+	u.setVersion(7)
+
+	return u, nil
+}
+
+// NewV7Monotonic creates a new time-ordered UUID like NewV7(), but guarantees
+// that every UUID it returns sorts strictly after the previous one returned
+// by the same Generator. If the clock has not moved forward since the last
+// call the random bits of the previous UUID are incremented by one instead,
+// carrying over into the timestamp if they overflow.
+func (g *Generator) NewV7Monotonic() (UUID, error) {
+	u, err := g.NewV7()
+	if err != nil {
+		return u, err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if u.v7Time() <= g.v7Last.v7Time() {
+		u = g.v7Last
+		u.incrementV7()
+	}
+
+	g.v7Last = u
+
+	return u, nil
+}
+
+// SetNodeID sets the node ID used by NewV1() and NewV6(), replacing the
+// random node ID which is generated by default.
+func (g *Generator) SetNodeID(node [6]byte) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.node = node
+	g.hasNode = true
+}
+
+// timeSetup initializes the clock sequence and node ID with random data
+// unless it has already been done, g.mu must be held.
+func (g *Generator) timeSetup() error {
+	if g.ready {
+		return nil
+	}
+
+	b := [8]byte{}
+
+	err := g.read(b[:])
+	if err != nil {
+		return err
+	}
+
+	if !g.hasNode {
+		copy(g.node[:], b[:6])
+
+		/* Set the multicast bit to mark the node ID as not being an
+		   IEEE 802 MAC-address, RFC 9562 section 6.10 */
+		g.node[0] |= 0x01
+	}
+
+	g.clockSeq = (uint16(b[6])<<8 | uint16(b[7])) & 0x3fff
+	g.ready = true
+
+	return nil
+}
+
+// timeNext returns the next 60-bit Gregorian timestamp, the clock sequence
+// and the node ID to use for a time-based UUID.
+//
+// The clock sequence is incremented whenever the clock does not move forward
+// between two calls, this includes both clock regressions and multiple calls
+// within the same 100-nanosecond interval. As the clock sequence only changes
+// when the timestamp fails to increase, no timestamp and clock sequence pair
+// is repeated by a Generator unless the 14-bit clock sequence wraps around
+// before the clock catches up.
+func (g *Generator) timeNext() (uint64, uint16, [6]byte, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.timeStep()
+}
+
+// timeNextV2 is timeNext for V2 UUIDs, where the clock sequence is instead
+// stepped for each UUID with the same domain, identifier and remaining
+// timestamp bits.
+func (g *Generator) timeNextV2(domain Domain, id uint32) (uint64, uint16, [6]byte, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	t, seq, node, err := g.timeStep()
+	if err != nil {
+		return t, seq, node, err
+	}
+
+	// Only the sequences used since the kept timestamp bits last changed matter
+	if g.v2Seq == nil || t>>32 != g.v2Time {
+		g.v2Time = t >> 32
+		g.v2Seq = make(map[v2Key]v2State)
+	}
+
+	k := v2Key{domain, id}
+
+	s, ok := g.v2Seq[k]
+	if !ok {
+		s.first = byte(seq>>8) & 0x3f
+		s.next = s.first
+	} else if s.next == s.first {
+		return t, seq, node, &ErrV2Exhausted{domain, id}
+	}
+
+	seq = uint16(s.next) << 8
+	s.next = (s.next + 1) & 0x3f
+
+	g.v2Seq[k] = s
+
+	return t, seq, node, nil
+}
+
+// timeStep returns the current timestamp, stepping the clock sequence if the
+// clock has not moved forward since the last call, g.mu must be held.
+func (g *Generator) timeStep() (uint64, uint16, [6]byte, error) {
+	err := g.timeSetup()
+	if err != nil {
+		return 0, 0, g.node, err
+	}
+
+	now := uint64(g.clock().UnixNano()/100) + gregorianOffset
+
+	if now <= g.last {
+		g.clockSeq = (g.clockSeq + 1) & 0x3fff
+	}
+
+	g.last = now
+
+	return now, g.clockSeq, g.node, nil
+}
+
+// randBufferSize is the size of each of the buffers in a buffered reader,
+// enough for 256 random UUIDs.
+const randBufferSize = 16 * 256
+
+// randBuffer is a buffer of random data, where pos is the position of the
+// first unused byte.
+type randBuffer struct {
+	buf [randBufferSize]byte
+	pos int
+}
+
+// bufferedReader reads from a pool of buffers filled from the underlying reader.
+type bufferedReader struct {
+	r    io.Reader
+	pool sync.Pool
+}
+
+// NewBufferedReader creates a reader which reads ahead from r in larger
+// blocks to reduce the number of reads from r, intended to be used as the
+// source of randomness for a Generator:
+//
+//	g := uuid.NewGenerator(uuid.NewBufferedReader(rand.Reader), nil)
+//
+// The returned reader is safe for concurrent use if r is. Buffers are kept
+// in a sync.Pool so that concurrent readers rarely contend over the same
+// buffer, and every byte read from r is returned at most once.
+func NewBufferedReader(r io.Reader) io.Reader {
+	b := &bufferedReader{r: r}
+
+	b.pool.New = func() interface{} {
+		return &randBuffer{pos: randBufferSize}
+	}
+
+	return b
+}
+
+// Read reads len(p) bytes into p, refilling the buffer from the underlying
+// reader as needed.
+func (b *bufferedReader) Read(p []byte) (int, error) {
+	if len(p) >= randBufferSize {
+		/* Buffering does not save anything here */
+		return io.ReadFull(b.r, p)
+	}
+
+	return b.fill(p)
+}
+
+// fill fills p from one of the pooled buffers.
+// Unlike Read it never passes p on to the underlying reader, so p does not
+// escape to the heap.
+func (b *bufferedReader) fill(p []byte) (int, error) {
+	rb := b.pool.Get().(*randBuffer)
+	defer b.pool.Put(rb)
+
+	n := 0
+
+	for n < len(p) {
+		if rb.pos == randBufferSize {
+			_, err := io.ReadFull(b.r, rb.buf[:])
+			if err != nil {
+				return n, err
+			}
+
+			rb.pos = 0
+		}
+
+		c := copy(p[n:], rb.buf[rb.pos:])
+		rb.pos += c
+		n += c
+	}
+
+	return n, nil
+}
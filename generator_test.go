@@ -0,0 +1,351 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+	"time"
+)
+
+// testClock returns a clock function which always returns the time t.
+func testClock(t time.Time) func() time.Time {
+	return func() time.Time {
+		return t
+	}
+}
+
+// Timestamp used in the RFC 9562 appendix A test vectors, 2022-02-22 19:22:22 UTC.
+var testRFCTime = time.Unix(1645557742, 0)
+
+func TestGeneratorNewV1(t *testing.T) {
+	/* Test vector from RFC 9562 appendix A.1, the first 6 bytes would be
+	   the random node ID which is replaced by SetNodeID() */
+	g := NewGenerator(bytes.NewReader([]byte{0, 0, 0, 0, 0, 0, 0x33, 0xc8}), testClock(testRFCTime))
+
+	g.SetNodeID([6]byte{0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46})
+
+	u, err := g.NewV1()
+	if err != nil {
+		t.Fatalf("NewV1() failed: %s", err.Error())
+	}
+
+	if u.String() != "c232ab00-9414-11ec-b3c8-9f6bdeced846" {
+		t.Errorf("NewV1() returned '%s', expected 'c232ab00-9414-11ec-b3c8-9f6bdeced846'", u.String())
+	}
+
+	/* Same timestamp again, must increment the clock sequence */
+	u, err = g.NewV1()
+	if err != nil {
+		t.Fatalf("NewV1() failed: %s", err.Error())
+	}
+
+	if u.String() != "c232ab00-9414-11ec-b3c9-9f6bdeced846" {
+		t.Errorf("NewV1() returned '%s', expected 'c232ab00-9414-11ec-b3c9-9f6bdeced846'", u.String())
+	}
+}
+
+func TestGeneratorNewV1RandomNode(t *testing.T) {
+	g := NewGenerator(bytes.NewReader([]byte{0x9e, 0x6b, 0xde, 0xce, 0xd8, 0x46, 0x33, 0xc8}), testClock(testRFCTime))
+
+	u, err := g.NewV1()
+	if err != nil {
+		t.Fatalf("NewV1() failed: %s", err.Error())
+	}
+
+	/* Multicast bit must be set on the random node ID */
+	if u.String() != "c232ab00-9414-11ec-b3c8-9f6bdeced846" {
+		t.Errorf("NewV1() returned '%s', expected 'c232ab00-9414-11ec-b3c8-9f6bdeced846'", u.String())
+	}
+}
+
+func TestGeneratorNewV2(t *testing.T) {
+	g := NewGenerator(bytes.NewReader([]byte{0, 0, 0, 0, 0, 0, 0x33, 0xc8}), testClock(testRFCTime))
+
+	g.SetNodeID([6]byte{0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46})
+
+	u, err := g.NewV2(DomainGroup, 501)
+	if err != nil {
+		t.Fatalf("NewV2() failed: %s", err.Error())
+	}
+
+	if u.String() != "000001f5-9414-21ec-b301-9f6bdeced846" {
+		t.Errorf("NewV2() returned '%s', expected '000001f5-9414-21ec-b301-9f6bdeced846'", u.String())
+	}
+}
+
+func TestGeneratorNewV2Unique(t *testing.T) {
+	now := testRFCTime
+	g := NewGenerator(nil, func() time.Time { return now })
+	seen := map[UUID]bool{}
+
+	for i := 0; i < 64; i++ {
+		u, err := g.NewV2(DomainPerson, 1000)
+		if err != nil {
+			t.Fatalf("NewV2() failed on call %d: %s", i+1, err.Error())
+		}
+
+		if seen[u] {
+			t.Fatalf("NewV2() returned '%s' twice", u.String())
+		}
+
+		seen[u] = true
+	}
+
+	_, err := g.NewV2(DomainPerson, 1000)
+	if e, ok := err.(*ErrV2Exhausted); !ok || e.Domain != DomainPerson || e.ID != 1000 {
+		t.Errorf("NewV2() expected ErrV2Exhausted after 64 UUIDs, got %v", err)
+	}
+
+	/* Other identifiers and domains have their own clock sequences */
+	for _, d := range []Domain{DomainPerson, DomainGroup} {
+		u, err := g.NewV2(d, 1001)
+		if err != nil {
+			t.Fatalf("NewV2() failed: %s", err.Error())
+		}
+
+		if seen[u] {
+			t.Errorf("NewV2() returned '%s' twice", u.String())
+		}
+	}
+
+	/* A timestamp change in the kept bits resets the clock sequences */
+	now = now.Add(10 * time.Minute)
+
+	if _, err := g.NewV2(DomainPerson, 1000); err != nil {
+		t.Errorf("NewV2() failed after the clock moved forward: %s", err.Error())
+	}
+}
+
+func TestGeneratorZero(t *testing.T) {
+	g := &Generator{}
+
+	for v, f := range map[Version]func() (UUID, error){
+		1: g.NewV1,
+		2: func() (UUID, error) { return g.NewV2(DomainPerson, 1000) },
+		4: g.NewV4,
+		6: g.NewV6,
+		7: g.NewV7,
+	} {
+		u, err := f()
+		if err != nil {
+			t.Fatalf("zero Generator failed to create %s UUID: %s", v, err.Error())
+		}
+
+		if u.Version() != v {
+			t.Errorf("zero Generator created %s UUID, expected %s: '%s'", u.Version(), v, u.String())
+		}
+	}
+
+	if err := g.NewV4Batch(make([]UUID, 4)); err != nil {
+		t.Errorf("zero Generator failed NewV4Batch(): %s", err.Error())
+	}
+}
+
+func TestGeneratorNewV4(t *testing.T) {
+	/* Test vector from RFC 9562 appendix A.3 */
+	g := NewGenerator(bytes.NewReader([]byte{
+		0x91, 0x91, 0x08, 0xf7, 0x52, 0xd1, 0x43, 0x20,
+		0x9b, 0xac, 0xf8, 0x47, 0xdb, 0x41, 0x48, 0xa8,
+	}), nil)
+
+	u, err := g.NewV4()
+	if err != nil {
+		t.Fatalf("NewV4() failed: %s", err.Error())
+	}
+
+	if u.String() != "919108f7-52d1-4320-9bac-f847db4148a8" {
+		t.Errorf("NewV4() returned '%s', expected '919108f7-52d1-4320-9bac-f847db4148a8'", u.String())
+	}
+
+	/* Reader is exhausted */
+	_, err = g.NewV4()
+	if err == nil {
+		t.Errorf("NewV4() did not fail on exhausted reader")
+	}
+}
+
+func TestGeneratorNewV6(t *testing.T) {
+	/* Test vector from RFC 9562 appendix A.4 */
+	g := NewGenerator(bytes.NewReader([]byte{0, 0, 0, 0, 0, 0, 0x33, 0xc8}), testClock(testRFCTime))
+
+	g.SetNodeID([6]byte{0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46})
+
+	u, err := g.NewV6()
+	if err != nil {
+		t.Fatalf("NewV6() failed: %s", err.Error())
+	}
+
+	if u.String() != "1ec9414c-232a-6b00-b3c8-9f6bdeced846" {
+		t.Errorf("NewV6() returned '%s', expected '1ec9414c-232a-6b00-b3c8-9f6bdeced846'", u.String())
+	}
+}
+
+func TestGeneratorNewV7(t *testing.T) {
+	/* Test vector from RFC 9562 appendix A.6 */
+	g := NewGenerator(bytes.NewReader([]byte{
+		0x0c, 0xc3, 0x18, 0xc4, 0xdc, 0x0c, 0x0c, 0x07, 0x39, 0x8f,
+	}), testClock(testRFCTime))
+
+	u, err := g.NewV7()
+	if err != nil {
+		t.Fatalf("NewV7() failed: %s", err.Error())
+	}
+
+	if u.String() != "017f22e2-79b0-7cc3-98c4-dc0c0c07398f" {
+		t.Errorf("NewV7() returned '%s', expected '017f22e2-79b0-7cc3-98c4-dc0c0c07398f'", u.String())
+	}
+}
+
+func TestGeneratorNewV7Monotonic(t *testing.T) {
+	g := NewGenerator(bytes.NewReader([]byte{
+		0x0c, 0xc3, 0x18, 0xc4, 0xdc, 0x0c, 0x0c, 0x07, 0x39, 0x8f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}), testClock(testRFCTime))
+
+	list := []string{
+		"017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+		"017f22e2-79b0-7cc3-98c4-dc0c0c073990",
+	}
+
+	for _, v := range list {
+		u, err := g.NewV7Monotonic()
+		if err != nil {
+			t.Fatalf("NewV7Monotonic() failed: %s", err.Error())
+		}
+
+		if u.String() != v {
+			t.Errorf("NewV7Monotonic() returned '%s', expected '%s'", u.String(), v)
+		}
+	}
+}
+
+func TestGeneratorDefaults(t *testing.T) {
+	g := NewGenerator(nil, nil)
+
+	u, err := g.NewV7()
+	if err != nil {
+		t.Fatalf("NewV7() failed: %s", err.Error())
+	}
+
+	if d := time.Since(time.UnixMilli(int64(u.v7Time()))); d < 0 || d > time.Minute {
+		t.Errorf("NewV7() with default clock returned a timestamp %s off: '%s'.", d, u.String())
+	}
+}
+
+func TestGeneratorSetNodeID(t *testing.T) {
+	node := [6]byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55}
+	g := NewGenerator(nil, nil)
+
+	g.SetNodeID(node)
+
+	u, err := g.NewV1()
+	if err != nil {
+		panic(err)
+	}
+
+	if string(u[10:]) != string(node[:]) {
+		t.Errorf("UUID generated from NewV1() does not have the node ID set by SetNodeID(): '%s'.", u.String())
+	}
+}
+
+func TestGeneratorNewV4Batch(t *testing.T) {
+	g := NewGenerator(bytes.NewReader([]byte{
+		0x91, 0x91, 0x08, 0xf7, 0x52, 0xd1, 0x43, 0x20,
+		0x9b, 0xac, 0xf8, 0x47, 0xdb, 0x41, 0x48, 0xa8,
+		0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef,
+		0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef,
+	}), nil)
+
+	dst := make([]UUID, 2)
+
+	err := g.NewV4Batch(dst)
+	if err != nil {
+		t.Fatalf("NewV4Batch() failed: %s", err.Error())
+	}
+
+	for i, v := range []string{
+		"919108f7-52d1-4320-9bac-f847db4148a8",
+		"01234567-89ab-4def-8123-456789abcdef",
+	} {
+		if dst[i].String() != v {
+			t.Errorf("NewV4Batch() returned '%s' at %d, expected '%s'", dst[i].String(), i, v)
+		}
+	}
+
+	/* Reader is exhausted */
+	err = g.NewV4Batch(dst)
+	if err == nil {
+		t.Errorf("NewV4Batch() did not fail on exhausted reader")
+	}
+}
+
+// testCounterReader returns consecutive big-endian uint32 counters, so that
+// every 4-byte aligned word it has returned is distinct.
+type testCounterReader struct {
+	n     uint32
+	max   uint32
+	reads []int
+}
+
+func (r *testCounterReader) Read(p []byte) (int, error) {
+	r.reads = append(r.reads, len(p))
+
+	for i := 0; i+4 <= len(p); i += 4 {
+		if r.n == r.max {
+			return i, io.EOF
+		}
+
+		binary.BigEndian.PutUint32(p[i:], r.n)
+		r.n++
+	}
+
+	return len(p) &^ 3, nil
+}
+
+func TestBufferedReader(t *testing.T) {
+	src := &testCounterReader{max: 4 * randBufferSize}
+	r := NewBufferedReader(src)
+	seen := map[uint32]bool{}
+
+	/* Mix of small reads and reads larger than the buffer, all multiples of
+	   4 bytes to keep the counters aligned */
+	for _, n := range []int{16, 16, 12, randBufferSize, 100, randBufferSize - 144, 16} {
+		b := make([]byte, n)
+
+		c, err := r.Read(b)
+		if err != nil {
+			t.Fatalf("Read() failed: %s", err.Error())
+		}
+
+		if c != n {
+			t.Fatalf("Read() returned %d bytes, expected %d", c, n)
+		}
+
+		for i := 0; i < n; i += 4 {
+			v := binary.BigEndian.Uint32(b[i:])
+			if seen[v] {
+				t.Fatalf("Read() returned the same data twice")
+			}
+
+			seen[v] = true
+		}
+	}
+
+	/* Small reads fill whole buffers, large reads go straight through */
+	for _, n := range src.reads {
+		if n != randBufferSize {
+			t.Errorf("underlying reader read %d bytes, expected %d", n, randBufferSize)
+		}
+	}
+
+	/* Drain the underlying reader to make the buffered one fail */
+	for i := 0; i < 4*randBufferSize; i++ {
+		_, err := r.Read(make([]byte, 16))
+		if err != nil {
+			return
+		}
+	}
+
+	t.Errorf("Read() did not fail on exhausted reader")
+}
@@ -2,6 +2,7 @@ package uuid
 
 import (
 	"bytes"
+	"fmt"
 )
 
 // ErrNotJSONString occurs when attempting to parse an UUID from a JSON string
@@ -12,71 +13,43 @@ func (e ErrNotJSONString) Error() string {
 	return "invalid UUID: invalid JSON string"
 }
 
+// ErrInvalidLength occurs when binary data does not have the length of a
+// binary UUID.
+type ErrInvalidLength struct {
+	// Length is the length of the supplied data.
+	Length int
+}
+
+func (e ErrInvalidLength) Error() string {
+	return fmt.Sprintf("invalid UUID: invalid length of binary data (length: %d)", e.Length)
+}
+
 var nullByteString = []byte("null")
 
 // MarshalText returns the string-representation of the UUID as a byte-array.
 func (u UUID) MarshalText() ([]byte, error) {
-	/* Inlined UUID.String() implementation, cannot reuse the one from
-	   UUID.String() as that cast will force an additional memory
-	   allocation because current version the compiler (go 1.3.1) cannot
-	   realize that the data pointer of the result of the UUID.String() call
-	   can be moved to the byte-slice return. Speed difference from inlining
-	   is about +20% */
-	/* Equivalent code:
-	   return []byte(u.String()), nil */
-	/* NOTE: If this is changed, also look at the following methods:
-	   UUID.MarshalJSON() and UUID.String() */
-	b := [36]byte{}
-
-	for i, n := range []int{
-		0, 2, 4, 6,
-		9, 11,
-		14, 16,
-		19, 21,
-		24, 26, 28, 30, 32, 34,
-	} {
-		b[n] = halfbyte2hexchar[(u[i]>>4)&0x0f]
-		b[n+1] = halfbyte2hexchar[u[i]&0x0f]
-	}
-
-	b[8] = '-'
-	b[13] = '-'
-	b[18] = '-'
-	b[23] = '-'
-
-	return b[:], nil
+	return u.AppendText(make([]byte, 0, 36))
 }
 
 // MarshalJSON returns the string-representation of the UUID as a JSON-string.
 func (u UUID) MarshalJSON() ([]byte, error) {
-	/* Needs a slightly different code yet inlined to prevent extra memory
-	   allocations, it needs to be 38 bytes and the indices are shifted once
-	   to the right to make room for quotation marks */
-	/* Equivalent code:
-	   return []byte("\"" + u.String() + "\""), nil */
-	/* NOTE: If this is changed, also look at the following methods:
-	   UUID.MarshalText() and UUID.String() */
-	b := [38]byte{}
-
-	for i, n := range []int{
-		1, 3, 5, 7,
-		10, 12,
-		15, 17,
-		20, 22,
-		25, 27, 29, 31, 33, 35,
-	} {
-		b[n] = halfbyte2hexchar[(u[i]>>4)&0x0f]
-		b[n+1] = halfbyte2hexchar[u[i]&0x0f]
-	}
+	return u.AppendJSON(make([]byte, 0, 38)), nil
+}
+
+// MarshalBinary returns the 16 bytes of the UUID, implementing
+// encoding.BinaryMarshaler which is also used by encoding/gob.
+func (u UUID) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 16)
 
-	b[0] = '"'
-	b[9] = '-'
-	b[14] = '-'
-	b[19] = '-'
-	b[24] = '-'
-	b[37] = '"'
+	copy(b, u[:])
 
-	return b[:], nil
+	return b, nil
+}
+
+// UnmarshalBinary reads 16 bytes into the UUID instance, returning
+// ErrInvalidLength if the data has any other length.
+func (u *UUID) UnmarshalBinary(data []byte) error {
+	return u.SetBytes(data)
 }
 
 // UnmarshalText reads an UUID from a string into the UUID instance.
@@ -100,66 +73,128 @@ func (u *UUID) UnmarshalJSON(data []byte) error {
 // MarshalJSON marshals a potentially null UUID into either a string-
 // representation of the UUID or the null-constant depending on the
 // Valid property.
-func (n NullUUID) MarshalJSON() ([]byte, error) {
-	if n.Valid {
-		return n.UUID.MarshalJSON()
-	} else {
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
 		return nullByteString, nil
 	}
+
+	return UUID(n.UUID).MarshalJSON()
 }
 
 // MarshalText marshals a potentially null UUID into either a string-
-// representation of the UUID or the null-constant depending on the
-// Valid property.
-func (n NullUUID) MarshalText() ([]byte, error) {
-	if n.Valid {
-		return n.UUID.MarshalText()
-	} else {
-		return nullByteString, nil
+// representation of the UUID or an empty value depending on the Valid
+// property, so that null survives text encodings like query strings and
+// form data.
+func (n Null[T]) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
 	}
+
+	return UUID(n.UUID).MarshalText()
 }
 
-// UnmarshalJSON parses a potentially null UUID into a NullUUI instance.
-// If the source is the null-constant ("null"), Valid is set to false,
-// otherwise it will attempt to parse the given string into the UUID property
-// of the NullUUID instance, setting Valid to true if no error is encountered.
-// If an error is encountered, Valid is set to false.
-func (n *NullUUID) UnmarshalJSON(data []byte) error {
-	if bytes.Compare(data, nullByteString) == 0 {
-		n.Valid = false
+// UnmarshalJSON parses a potentially null UUID into a Null instance.
+// If the source is the null-constant (null), Valid is set to false,
+// otherwise the source has to be a JSON-string containing an UUID, or
+// ErrNotJSONString is returned. An empty JSON-string is an error, see
+// NullEmpty. If an error is encountered, Valid is set to false.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	n.Valid = false
+
+	if bytes.Equal(data, nullByteString) {
 		return nil
 	}
 
-	dataLen := len(data)
-	if dataLen < 2 {
+	l := len(data)
+
+	if l < 2 || data[0] != '"' || data[l-1] != '"' {
+		return &ErrNotJSONString{}
+	}
+
+	return n.unmarshalText(data[1 : l-1])
+}
+
+// UnmarshalJSON parses a potentially null UUID into a NullEmpty instance
+// like Null.UnmarshalJSON(), but also reads an empty JSON-string ("") as
+// null.
+func (n *NullEmpty[T]) UnmarshalJSON(data []byte) error {
+	if len(data) == 2 && data[0] == '"' && data[1] == '"' {
 		n.Valid = false
+
 		return nil
 	}
 
-	err := n.UUID.ReadBytes(data[1 : len(data)-1])
+	return n.Null.UnmarshalJSON(data)
+}
 
-	/* Because we modify in place we set Valid to false in case of an error */
-	n.Valid = err == nil
+// UnmarshalText parses a potentially null UUID into a Null instance.
+// If the source is empty or the null-constant ("null"), Valid is set to
+// false, otherwise it will attempt to parse the given string into the UUID
+// property of the Null instance, setting Valid to true if no error is
+// encountered. If an error is encountered, Valid is set to false.
+func (n *Null[T]) UnmarshalText(data []byte) error {
+	n.Valid = false
 
-	return err
+	if len(data) == 0 || bytes.Equal(data, nullByteString) {
+		return nil
+	}
+
+	return n.unmarshalText(data)
 }
 
-// UnmarshalText parses a potentially null UUID into a NullUUI instance.
-// If the source is the null-constant ("null"), Valid is set to false,
-// otherwise it will attempt to parse the given string into the UUID property
-// of the NullUUID instance, setting Valid to true if no error is encountered.
-// If an error is encountered, Valid is set to false.
-func (n *NullUUID) UnmarshalText(data []byte) error {
-	if bytes.Compare(data, nullByteString) == 0 {
-		n.Valid = false
+func (n *Null[T]) unmarshalText(data []byte) error {
+	var u UUID
 
-		return nil
+	if err := u.ReadBytes(data); err != nil {
+		return err
+	}
+
+	n.UUID, n.Valid = T(u), true
+
+	return nil
+}
+
+// MarshalBinary marshals a potentially null UUID into a presence byte
+// followed by the 16 bytes of the UUID, or only a zero byte if it is null.
+func (n Null[T]) MarshalBinary() ([]byte, error) {
+	if !n.Valid {
+		return []byte{0}, nil
 	}
 
-	err := n.UUID.ReadBytes(data)
+	b := make([]byte, 17)
+	b[0] = 1
 
-	/* Because we modify in place we set Valid to false in case of an error */
-	n.Valid = err == nil
+	copy(b[1:], n.UUID[:])
+
+	return b, nil
+}
+
+// UnmarshalBinary parses a potentially null UUID in the format produced by
+// MarshalBinary into a Null instance. If an error is encountered,
+// Valid is set to false.
+func (n *Null[T]) UnmarshalBinary(data []byte) error {
+	n.Valid = false
+
+	if len(data) == 0 {
+		return &ErrInvalidLength{len(data)}
+	}
+
+	switch data[0] {
+	case 0:
+		if len(data) != 1 {
+			return &ErrInvalidLength{len(data)}
+		}
+
+		return nil
+	case 1:
+		var u UUID
+
+		err := u.UnmarshalBinary(data[1:])
+
+		n.UUID, n.Valid = T(u), err == nil
+
+		return err
+	}
 
-	return err
+	return &ErrSyntax{0, len(data)}
 }
@@ -2,6 +2,8 @@ package uuid
 
 import (
 	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"testing"
@@ -152,6 +154,87 @@ func TestUUIDUnmarshalJSONShort(t *testing.T) {
 	}
 }
 
+func TestUUIDMarshalBinary(t *testing.T) {
+	u := MustFromString(testStringUUID)
+
+	b, err := u.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %s", err.Error())
+	}
+
+	if !bytes.Equal(b, u[:]) {
+		t.Errorf("MarshalBinary() returned %x, expected %x", b, u[:])
+	}
+
+	/* Must not share memory with the UUID */
+	b[0] = 0
+
+	if u.String() != testStringUUID {
+		t.Errorf("MarshalBinary() result shares memory with the UUID")
+	}
+}
+
+func TestUUIDUnmarshalBinary(t *testing.T) {
+	u := UUID{}
+	v := MustFromString(testStringUUID)
+
+	err := u.UnmarshalBinary(v[:])
+	if err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %s", err.Error())
+	}
+
+	if u != v {
+		t.Errorf("UnmarshalBinary() returned '%s', expected '%s'", u.String(), v.String())
+	}
+
+	for _, b := range [][]byte{nil, v[:15], append(v[:], 0), testByteUUID} {
+		err := u.UnmarshalBinary(b)
+		if e, ok := err.(*ErrInvalidLength); !ok || e.Length != len(b) {
+			t.Errorf("UnmarshalBinary(%x) expected ErrInvalidLength, got %v", b, err)
+		}
+	}
+}
+
+func TestGob(t *testing.T) {
+	type record struct {
+		ID     UUID
+		Parent NullUUID
+		Owner  NullUUID
+	}
+
+	r := record{
+		ID:     MustFromString(testStringUUID),
+		Parent: NullUUID{Valid: true, UUID: MustFromString("c56a4180-65aa-42ec-a945-5fd21dec0538")},
+	}
+
+	buf := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buf).Encode(r)
+	if err != nil {
+		t.Fatalf("gob Encode() failed: %s", err.Error())
+	}
+
+	/* NOTE: gob omits zero values, so a null NullUUID is not sent at all */
+	d := record{}
+
+	err = gob.NewDecoder(&buf).Decode(&d)
+	if err != nil {
+		t.Fatalf("gob Decode() failed: %s", err.Error())
+	}
+
+	if d.ID != r.ID || d.Parent != r.Parent || d.Owner.Valid {
+		t.Errorf("gob did not round-trip %+v, got %+v", r, d)
+	}
+}
+
+func BenchmarkMarshalBinary(b *testing.B) {
+	u := MustFromString(testStringUUID)
+
+	for i := 0; i < b.N; i++ {
+		u.MarshalBinary()
+	}
+}
+
 func BenchmarkUnmarshalText(b *testing.B) {
 	u := UUID{}
 
@@ -218,8 +301,8 @@ func TestNullUUIDMarshalText2(t *testing.T) {
 		t.Error("expected NullUUID.MarshalText() to have err == nil, got '" + reflect.TypeOf(b).String() + "'.")
 	}
 
-	if bytes.Compare(a, []byte("null")) != 0 {
-		t.Error(fmt.Sprintf("expected NullUUID.MarshalText() to return '%x', got '%x'.", []byte("null"), a))
+	if len(a) != 0 {
+		t.Error(fmt.Sprintf("expected NullUUID.MarshalText() to return empty, got '%x'.", a))
 	}
 }
 
@@ -313,6 +396,24 @@ func TestNullUUIDUnmarshalText2(t *testing.T) {
 	}
 }
 
+func TestNullUUIDUnmarshalTextEmpty(t *testing.T) {
+	n := NullUUID{Valid: true, UUID: MustFromString("12345678-9abc-deff-edcb-a98765432100")}
+
+	b, err := NullUUID{}.MarshalText()
+	if err != nil {
+		t.Fatalf("NullUUID.MarshalText() failed: %s", err.Error())
+	}
+
+	err = n.UnmarshalText(b)
+	if err != nil {
+		t.Errorf("Failed to read empty text: %s", err.Error())
+	}
+
+	if n.Valid {
+		t.Error("Parsing empty text did not set Valid to false.")
+	}
+}
+
 func TestNullUUIDUnmarshalJSON1(t *testing.T) {
 	list := []string{
 		"00000000-0000-0000-0000-00000000000f",
@@ -358,6 +459,131 @@ func TestInvalidStringForUUID(t *testing.T) {
 	}
 }
 
+func TestNullUUIDUnmarshalJSONInvalid(t *testing.T) {
+	list := []string{
+		"",
+		"0",
+		`"`,
+		"nul",
+		"NULL",
+		"12345678-9abc-deff-edcb-a98765432100",
+		`"12345678-9abc-deff-edcb-a98765432100`,
+		`12345678-9abc-deff-edcb-a98765432100"`,
+		`'12345678-9abc-deff-edcb-a98765432100'`,
+	}
+
+	for _, i := range list {
+		n := NullUUID{Valid: true}
+
+		err := n.UnmarshalJSON([]byte(i))
+		if _, ok := err.(*ErrNotJSONString); !ok {
+			t.Errorf("NullUUID.UnmarshalJSON(%s) expected ErrNotJSONString, got %v", i, err)
+		}
+
+		if n.Valid {
+			t.Errorf("NullUUID.UnmarshalJSON(%s) did not set Valid to false", i)
+		}
+	}
+
+	n := NullUUID{Valid: true}
+
+	if err := n.UnmarshalJSON([]byte(`"12345678"`)); err == nil || n.Valid {
+		t.Error("NullUUID.UnmarshalJSON() did not fail on a short UUID")
+	}
+}
+
+func TestNullUUIDUnmarshalJSONEmpty(t *testing.T) {
+	n := NullUUID{Valid: true}
+
+	if err := n.UnmarshalJSON([]byte(`""`)); err == nil {
+		t.Error("NullUUID.UnmarshalJSON() did not fail on an empty string")
+	}
+
+	e := NullEmpty[UUID]{NullUUID{Valid: true}}
+
+	if err := e.UnmarshalJSON([]byte(`""`)); err != nil {
+		t.Errorf("NullEmpty.UnmarshalJSON() failed on an empty string: %s", err.Error())
+	}
+
+	if e.Valid {
+		t.Error("Parsing an empty string did not set Valid to false.")
+	}
+
+	list := map[string]NullEmpty[UUID]{
+		`null`:                                   {},
+		`"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"`: {NullUUID{Valid: true, UUID: MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")}},
+	}
+
+	for i, v := range list {
+		e = NullEmpty[UUID]{}
+
+		if err := json.Unmarshal([]byte(i), &e); err != nil || e != v {
+			t.Errorf("NullEmpty.UnmarshalJSON(%s) returned %+v, %v, expected %+v", i, e, err, v)
+		}
+	}
+
+	if err := e.UnmarshalJSON([]byte(`0`)); err == nil {
+		t.Error("NullEmpty.UnmarshalJSON() did not fail on a number")
+	}
+
+	b, err := json.Marshal(NullEmpty[UUID]{})
+	if err != nil || string(b) != "null" {
+		t.Errorf("json.Marshal() of NullEmpty returned %s, %v, expected null", b, err)
+	}
+}
+
+func TestNullUUIDJSONRoundTrip(t *testing.T) {
+	type T struct {
+		ID     NullUUID `json:"id"`
+		Parent NullUUID `json:"parent"`
+	}
+
+	v := T{ID: NullUUID{Valid: true, UUID: MustFromString("12345678-9abc-deff-edcb-a98765432100")}}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal() failed: %s", err.Error())
+	}
+
+	if string(b) != `{"id":"12345678-9abc-deff-edcb-a98765432100","parent":null}` {
+		t.Errorf("json.Marshal() returned %s", b)
+	}
+
+	r := T{Parent: NullUUID{Valid: true}}
+
+	if err := json.Unmarshal(b, &r); err != nil {
+		t.Fatalf("json.Unmarshal() failed: %s", err.Error())
+	}
+
+	if r != v {
+		t.Errorf("json.Unmarshal() returned %+v, expected %+v", r, v)
+	}
+}
+
+func TestNullPtr(t *testing.T) {
+	u := MustFromString("12345678-9abc-deff-edcb-a98765432100")
+
+	if p := (NullUUID{UUID: u}).Ptr(); p != nil {
+		t.Errorf("NullUUID.Ptr() returned %v for null", p)
+	}
+
+	if p := (NullUUID{Valid: true, UUID: u}).Ptr(); p == nil || *p != u {
+		t.Errorf("NullUUID.Ptr() returned %v, expected %s", p, u)
+	}
+
+	if n := FromPtr(nil); n.Valid {
+		t.Error("FromPtr(nil) returned a valid NullUUID")
+	}
+
+	if n := FromPtr(&u); !n.Valid || n.UUID != u {
+		t.Errorf("FromPtr() returned %+v, expected %s", n, u)
+	}
+
+	if p := FromPtr(&u).Ptr(); p == &u {
+		t.Error("NullUUID.Ptr() did not return a copy")
+	}
+}
+
 func TestNullUUIDUnmarshalJSON2(t *testing.T) {
 	u, err := FromString("12345678-9abc-deff-edcb-a98765432100")
 	if err != nil {
@@ -453,3 +679,61 @@ func BenchmarkNullMarshalJSON2(b *testing.B) {
 		n.MarshalJSON()
 	}
 }
+
+func TestNullUUIDMarshalBinary(t *testing.T) {
+	u := MustFromString(testStringUUID)
+
+	b, err := NullUUID{Valid: true, UUID: u}.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %s", err.Error())
+	}
+
+	if !bytes.Equal(b, append([]byte{1}, u[:]...)) {
+		t.Errorf("MarshalBinary() returned %x, expected 01%x", b, u[:])
+	}
+
+	b, err = NullUUID{UUID: u}.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %s", err.Error())
+	}
+
+	if !bytes.Equal(b, []byte{0}) {
+		t.Errorf("MarshalBinary() on null returned %x, expected 00", b)
+	}
+}
+
+func TestNullUUIDUnmarshalBinary(t *testing.T) {
+	u := MustFromString(testStringUUID)
+	n := NullUUID{}
+
+	err := n.UnmarshalBinary(append([]byte{1}, u[:]...))
+	if err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %s", err.Error())
+	}
+
+	if !n.Valid || n.UUID != u {
+		t.Errorf("UnmarshalBinary() returned %+v, expected valid '%s'", n, u.String())
+	}
+
+	err = n.UnmarshalBinary([]byte{0})
+	if err != nil {
+		t.Fatalf("UnmarshalBinary() failed on null: %s", err.Error())
+	}
+
+	if n.Valid {
+		t.Errorf("UnmarshalBinary() on null did not set Valid to false")
+	}
+
+	for _, b := range [][]byte{nil, {0, 0}, {1}, append([]byte{1}, u[:15]...), append([]byte{2}, u[:]...)} {
+		n.Valid = true
+
+		err := n.UnmarshalBinary(b)
+		if err == nil {
+			t.Errorf("UnmarshalBinary(%x) did not fail", b)
+		}
+
+		if n.Valid {
+			t.Errorf("UnmarshalBinary(%x) did not set Valid to false on error", b)
+		}
+	}
+}
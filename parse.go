@@ -0,0 +1,192 @@
+package uuid
+
+import (
+	"fmt"
+)
+
+// ErrSyntax occurs when a string does not match any of the layouts accepted
+// by the strict parser.
+type ErrSyntax struct {
+	// Position is the index of the first unexpected character in the source
+	// string, equal to Length if the string ended too early.
+	Position int
+	// Length is the length of the source string.
+	Length int
+}
+
+func (e ErrSyntax) Error() string {
+	if e.Position >= e.Length {
+		return fmt.Sprintf("invalid UUID: unexpected end of string (string length: %d)", e.Length)
+	}
+
+	return fmt.Sprintf("invalid UUID: unexpected character at position %d (string length: %d)", e.Position, e.Length)
+}
+
+// urnPrefix is the prefix of the URN form of a UUID, RFC 9562 section 4.
+const urnPrefix = "urn:uuid:"
+
+// ParseStrict reads a UUID into a new UUID instance, only accepting the
+// following layouts with hexadecimal digits of any case:
+//
+//	a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11
+//	{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11}
+//	urn:uuid:a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11
+//	a0eebc999c0b4ef8bb6d6bb9bd380a11
+//
+// The "urn:uuid:" prefix is matched case-insensitively. Any other input
+// results in an ErrSyntax with the position of the first offending
+// character.
+func ParseStrict(str string) (UUID, error) {
+	u := UUID{}
+
+	err := parseStrict(&u, str)
+
+	return u, err
+}
+
+// ParseStrictBytes reads a UUID from a byte array into a new UUID instance,
+// accepting the same layouts as ParseStrict().
+func ParseStrictBytes(str []byte) (UUID, error) {
+	u := UUID{}
+
+	err := parseStrict(&u, str)
+
+	return u, err
+}
+
+// ParseURN reads a UUID in the URN form into a new UUID instance, only
+// accepting the canonical layout prefixed by "urn:uuid:":
+//
+//	urn:uuid:a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11
+//
+// The prefix is matched case-insensitively, URNs of other namespaces are
+// rejected with an ErrSyntax at the position where the prefix differs.
+func ParseURN(str string) (UUID, error) {
+	u := UUID{}
+
+	err := parseURN(&u, str)
+
+	return u, err
+}
+
+// ParseURNBytes reads a UUID in the URN form from a byte array into a new
+// UUID instance, see ParseURN().
+func ParseURNBytes(str []byte) (UUID, error) {
+	u := UUID{}
+
+	err := parseURN(&u, str)
+
+	return u, err
+}
+
+// parseURN reads the supplied URN into u, see ParseURN().
+func parseURN[T string | []byte](u *UUID, str T) error {
+	x := matchURNPrefix(str)
+	if x < len(urnPrefix) {
+		return &ErrSyntax{x, len(str)}
+	}
+
+	x, err := parseHex(u, str, x, true)
+	if err != nil {
+		return err
+	}
+
+	if x != len(str) {
+		return &ErrSyntax{x, len(str)}
+	}
+
+	return nil
+}
+
+// parseStrict reads the supplied string into u, only accepting the layouts
+// listed by ParseStrict(). On error u will be partially populated.
+func parseStrict[T string | []byte](u *UUID, str T) error {
+	c := len(str)
+	x := 0
+	braced := false
+
+	if c > 0 && str[0] == '{' {
+		braced = true
+		x = 1
+	} else if n := matchURNPrefix(str); n >= len("urn:") {
+		if n < len(urnPrefix) {
+			/* URN of some other namespace */
+			return &ErrSyntax{n, c}
+		}
+
+		x = n
+	}
+
+	/* Compact form has a hexadecimal digit where the first hyphen would be */
+	hyphens := x > 0 || c < 9 || str[8] == '-'
+
+	x, err := parseHex(u, str, x, hyphens)
+	if err != nil {
+		return err
+	}
+
+	if braced {
+		if x >= c || str[x] != '}' {
+			return &ErrSyntax{x, c}
+		}
+
+		x++
+	}
+
+	if x != c {
+		return &ErrSyntax{x, c}
+	}
+
+	return nil
+}
+
+// parseHex reads 32 hexadecimal digits from str starting at x into u,
+// optionally requiring hyphens in the canonical positions, and returns the
+// position after the last digit.
+func parseHex[T string | []byte](u *UUID, str T, x int, hyphens bool) (int, error) {
+	c := len(str)
+
+	for i := 0; i < 16; i++ {
+		if hyphens && (i == 4 || i == 6 || i == 8 || i == 10) {
+			if x >= c || str[x] != '-' {
+				return x, &ErrSyntax{x, c}
+			}
+
+			x++
+		}
+
+		if x >= c || hexchar2byte[str[x]] == 255 {
+			return x, &ErrSyntax{x, c}
+		}
+		if x+1 >= c || hexchar2byte[str[x+1]] == 255 {
+			return x + 1, &ErrSyntax{x + 1, c}
+		}
+
+		u[i] = hexchar2byte[str[x]]<<4 | hexchar2byte[str[x+1]]
+
+		x += 2
+	}
+
+	return x, nil
+}
+
+// matchURNPrefix returns the number of leading characters of str matching
+// "urn:uuid:", ignoring case.
+func matchURNPrefix[T string | []byte](str T) int {
+	for i := 0; i < len(urnPrefix); i++ {
+		if i >= len(str) || toLower(str[i]) != urnPrefix[i] {
+			return i
+		}
+	}
+
+	return len(urnPrefix)
+}
+
+// toLower converts an ASCII upper case letter to lower case.
+func toLower(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+
+	return c
+}
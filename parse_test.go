@@ -0,0 +1,130 @@
+package uuid
+
+import (
+	"testing"
+)
+
+// testStrict maps inputs to the expected UUID, or the expected position of
+// the syntax error if the expected string is empty.
+var testStrict = map[string]struct {
+	Str      string
+	Position int
+}{
+	"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11":            {"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", 0},
+	"A0EEBC99-9C0B-4EF8-BB6D-6BB9BD380A11":            {"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", 0},
+	"{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11}":          {"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", 0},
+	"urn:uuid:a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11":   {"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", 0},
+	"URN:UUID:a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11":   {"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", 0},
+	"a0eebc999c0b4ef8bb6d6bb9bd380a11":                {"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", 0},
+	"A0EEBC999C0B4EF8BB6D6BB9BD380A11":                {"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", 0},
+	"":                                                {"", 0},
+	"a0eebc99":                                        {"", 8},
+	"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a1":             {"", 35},
+	"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a111":           {"", 36},
+	"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11}":           {"", 36},
+	"{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11":           {"", 37},
+	"{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11}}":         {"", 38},
+	"{a0eebc999c0b4ef8bb6d6bb9bd380a11}":              {"", 9},
+	"a0eebc99-9c0b-4ef8-bb6d6bb9bd380a11":             {"", 23},
+	"a0ee-bc99-9c0b-4ef8-bb6d-6bb9-bd38-0a11":         {"", 4},
+	"a0eebc99This9cIs0b4eOKf8bb6d6bb9bdLOL380a11":     {"", 8},
+	"a0eebc99,9c0b,4ef8,bb6d,6bb9bd380a11":            {"", 8},
+	"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a1x":            {"", 35},
+	"x0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11":            {"", 0},
+	"urn:uuid:{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11}": {"", 9},
+	"urn:uuid:a0eebc999c0b4ef8bb6d6bb9bd380a11":       {"", 17},
+	"a0eebc999c0b4ef8bb6d6bb9bd380a11a":               {"", 32},
+}
+
+func TestParseStrict(t *testing.T) {
+	for i, v := range testStrict {
+		for _, parse := range []func(string) (UUID, error){
+			ParseStrict,
+			func(s string) (UUID, error) { return ParseStrictBytes([]byte(s)) },
+		} {
+			u, err := parse(i)
+			if v.Str != "" {
+				if err != nil {
+					t.Errorf("ParseStrict(%s): expected '%s', got error: %s", i, v.Str, err.Error())
+				} else if u.String() != v.Str {
+					t.Errorf("ParseStrict(%s): string representation '%s' does not match '%s'.", i, u.String(), v.Str)
+				}
+
+				continue
+			}
+
+			e, ok := err.(*ErrSyntax)
+			if !ok {
+				t.Errorf("ParseStrict(%s): expected ErrSyntax, got %v", i, err)
+			} else if e.Position != v.Position || e.Length != len(i) {
+				t.Errorf("ParseStrict(%s): expected error at position %d, got %d (length %d)", i, v.Position, e.Position, e.Length)
+			}
+		}
+	}
+}
+
+func TestParseURN(t *testing.T) {
+	list := map[string]struct {
+		Str      string
+		Position int
+	}{
+		"urn:uuid:a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11":  {"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", 0},
+		"URN:UUID:A0EEBC99-9C0B-4EF8-BB6D-6BB9BD380A11":  {"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", 0},
+		"Urn:Uuid:a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11":  {"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", 0},
+		"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11":           {"", 0},
+		"{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11}":         {"", 0},
+		"urn:isbn:0451450523":                            {"", 4},
+		"urn:uuid:":                                      {"", 9},
+		"urn:uuid:a0eebc999c0b4ef8bb6d6bb9bd380a11":      {"", 17},
+		"urn:uuid:a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11 ": {"", 45},
+	}
+
+	for i, v := range list {
+		for _, parse := range []func(string) (UUID, error){
+			ParseURN,
+			func(s string) (UUID, error) { return ParseURNBytes([]byte(s)) },
+		} {
+			u, err := parse(i)
+			if v.Str != "" {
+				if err != nil {
+					t.Errorf("ParseURN(%s): expected '%s', got error: %s", i, v.Str, err.Error())
+				} else if u.String() != v.Str {
+					t.Errorf("ParseURN(%s): string representation '%s' does not match '%s'.", i, u.String(), v.Str)
+				}
+
+				continue
+			}
+
+			e, ok := err.(*ErrSyntax)
+			if !ok {
+				t.Errorf("ParseURN(%s): expected ErrSyntax, got %v", i, err)
+			} else if e.Position != v.Position {
+				t.Errorf("ParseURN(%s): expected error at position %d, got %d", i, v.Position, e.Position)
+			}
+		}
+	}
+}
+
+func TestErrSyntax(t *testing.T) {
+	_, err := ParseStrict("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a1x")
+	if err.Error() != "invalid UUID: unexpected character at position 35 (string length: 36)" {
+		t.Errorf("unexpected error message '%s'", err.Error())
+	}
+
+	_, err = ParseStrict("a0eebc99-9c0b-4ef8")
+	if err.Error() != "invalid UUID: unexpected end of string (string length: 18)" {
+		t.Errorf("unexpected error message '%s'", err.Error())
+	}
+}
+
+func BenchmarkParseStrict(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ParseStrict("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+	}
+}
+
+func BenchmarkParseStrictBytes(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ParseStrictBytes(testByteUUID)
+	}
+}
@@ -0,0 +1,7 @@
+//go:build race
+
+package uuid
+
+// raceEnabled is true when the race detector is enabled, which makes
+// otherwise allocation-free calls allocate.
+const raceEnabled = true
@@ -1,12 +1,14 @@
 package uuid
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"fmt"
 	"reflect"
 )
 
-// ErrInvalidType occurs when *UUID.Scan() does not receive a string.
+// ErrInvalidType occurs when Scan() receives a value of a type it cannot
+// read from, see the Scan() method of the destination for the accepted types.
 type ErrInvalidType struct {
 	Type reflect.Type
 }
@@ -16,20 +18,38 @@ func (e ErrInvalidType) Error() string {
 	if e.Type != nil {
 		t = e.Type.String()
 	}
-	return fmt.Sprintf("uuid Scan(): invalid type '%s', expected string or []byte.", t)
+	return fmt.Sprintf("uuid Scan(): invalid type '%s'.", t)
 }
 
 // Scan scans a uuid from the given interface instance.
+// A []byte of exactly 16 bytes is read as a binary UUID, as used by
+// BINARY(16) and BLOB columns and the PostgreSQL binary wire format, any
+// other string or []byte as text. [16]byte, UUID and *UUID values are
+// copied as-is and any other fmt.Stringer is parsed from its String().
 // If scanning fails the state of the UUID is undetermined.
 func (u *UUID) Scan(val interface{}) error {
-	if s, ok := val.(string); ok {
-		return u.SetString(s)
-	}
-	if b, ok := val.([]byte); ok {
-		return u.ReadBytes(b)
+	switch v := val.(type) {
+	case string:
+		return u.SetString(v)
+	case []byte:
+		return u.parseBytes(v)
+	case [16]byte:
+		*u = v
+	case UUID:
+		*u = v
+	case *UUID:
+		if v == nil {
+			return &ErrInvalidType{reflect.TypeOf(val)}
+		}
+
+		*u = *v
+	case fmt.Stringer:
+		return u.SetString(v.String())
+	default:
+		return &ErrInvalidType{reflect.TypeOf(val)}
 	}
 
-	return &ErrInvalidType{reflect.TypeOf(val)}
+	return nil
 }
 
 // Value gives the database driver representation of the UUID.
@@ -38,27 +58,222 @@ func (u UUID) Value() (driver.Value, error) {
 	return u.String(), nil
 }
 
-// Scan scans a uuid or null from the given value.
+// Scan scans a uuid or null from the given value, using the Scan method of
+// T if it has one and UUID.Scan() otherwise.
 // If the supplied value is nil, Valid will be set to false and the
 // UUID will be zeroed.
-func (nu *NullUUID) Scan(val interface{}) error {
+func (n *Null[T]) Scan(val interface{}) error {
 	if val == nil {
-		nu.UUID, nu.Valid = [16]byte{}, false
+		n.UUID, n.Valid = T{}, false
 
 		return nil
 	}
 
-	nu.Valid = true
+	if s, ok := interface{}(&n.UUID).(sql.Scanner); ok {
+		err := s.Scan(val)
+
+		n.Valid = err == nil
+
+		return err
+	}
 
-	return nu.UUID.Scan(val)
+	var u UUID
+
+	err := u.Scan(val)
+
+	n.UUID, n.Valid = T(u), err == nil
+
+	return err
 }
 
-// Value gives the database driver representation of the UUID or NULL.
-func (nu NullUUID) Value() (driver.Value, error) {
-	if !nu.Valid {
+// Value gives the database driver representation of the UUID or NULL,
+// using the Value method of T if it has one and UUID.Value() otherwise.
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
 		return nil, nil
 	}
 
-	// The return here causes a second allocation because of the driver.Value interface{} box
-	return nu.UUID.String(), nil
+	// Checking the nil pointer avoids boxing the UUID for the common case
+	if _, ok := interface{}((*T)(nil)).(*UUID); !ok {
+		if v, ok := interface{}(n.UUID).(driver.Valuer); ok {
+			return v.Value()
+		}
+	}
+
+	return UUID(n.UUID).Value()
+}
+
+// BinaryUUID is a UUID which is stored as 16 raw bytes in the database,
+// for BINARY(16), BLOB and RAW(16) columns. Convert to and from UUID to use
+// it as a query argument or scan destination:
+//
+//	db.Exec("INSERT INTO t (id) VALUES (?)", uuid.BinaryUUID(u))
+//	row.Scan((*uuid.BinaryUUID)(&u))
+//
+// Only the database representation differs from UUID, a BinaryUUID struct
+// field is formatted and marshalled to text and JSON as a string.
+type BinaryUUID UUID
+
+// Scan scans a uuid from the given value like UUID.Scan(), accepting both
+// binary and textual UUIDs.
+// If scanning fails the state of the UUID is undetermined.
+func (b *BinaryUUID) Scan(val interface{}) error {
+	return (*UUID)(b).Scan(val)
+}
+
+// Value gives the database driver representation of the UUID as 16 bytes.
+func (b BinaryUUID) Value() (driver.Value, error) {
+	v := make([]byte, 16)
+
+	copy(v, b[:])
+
+	return v, nil
+}
+
+// String returns the canonical string representation of the UUID.
+func (b BinaryUUID) String() string {
+	return UUID(b).String()
+}
+
+// Format formats the UUID like UUID.Format().
+func (b BinaryUUID) Format(f fmt.State, verb rune) {
+	UUID(b).Format(f, verb)
+}
+
+// MarshalText returns the canonical string representation of the UUID,
+// which encoding/json also uses for JSON.
+func (b BinaryUUID) MarshalText() ([]byte, error) {
+	return UUID(b).MarshalText()
+}
+
+// UnmarshalText reads a textual UUID like UUID.UnmarshalText(), which
+// encoding/json also uses for JSON-strings.
+func (b *BinaryUUID) UnmarshalText(data []byte) error {
+	return (*UUID)(b).UnmarshalText(data)
+}
+
+// NullBinaryUUID is a BinaryUUID that may be null, stored as 16 raw bytes
+// in the database. It marshals to JSON and text like NullUUID.
+type NullBinaryUUID = Null[BinaryUUID]
+
+// MySQLSwapped is a UUID which is stored as 16 bytes in the database with
+// the time fields swapped like MySQL's UUID_TO_BIN(uuid, 1), making V1 UUIDs
+// index in time order. Values read from the database are swapped back like
+// BIN_TO_UUID(bin, 1), so Go and SQL conversions agree:
+//
+//	db.Exec("INSERT INTO t (id) VALUES (?)", uuid.MySQLSwapped(u))
+//	db.Exec("INSERT INTO t (id) VALUES (UUID_TO_BIN(?, 1))", u)
+//	row.Scan((*uuid.MySQLSwapped)(&u))
+//
+// The swap only applies to the binary database representation, formatting
+// and text or JSON marshalling use the unswapped UUID.
+type MySQLSwapped UUID
+
+// Scan scans a uuid from the given value like UUID.Scan(), swapping the
+// time fields back if it is a binary UUID. Textual UUIDs are read as-is.
+// If scanning fails the state of the UUID is undetermined.
+func (m *MySQLSwapped) Scan(val interface{}) error {
+	if b, ok := val.([]byte); ok && len(b) == 16 {
+		copy(m[0:4], b[4:8])
+		copy(m[4:6], b[2:4])
+		copy(m[6:8], b[0:2])
+		copy(m[8:], b[8:])
+
+		return nil
+	}
+
+	return (*UUID)(m).Scan(val)
+}
+
+// Value gives the database driver representation of the UUID as 16 bytes
+// with time-high first, followed by time-mid and time-low.
+func (m MySQLSwapped) Value() (driver.Value, error) {
+	b := make([]byte, 16)
+
+	copy(b[0:2], m[6:8])
+	copy(b[2:4], m[4:6])
+	copy(b[4:8], m[0:4])
+	copy(b[8:], m[8:])
+
+	return b, nil
+}
+
+// String returns the canonical string representation of the unswapped UUID.
+func (m MySQLSwapped) String() string {
+	return UUID(m).String()
+}
+
+// Format formats the unswapped UUID like UUID.Format().
+func (m MySQLSwapped) Format(f fmt.State, verb rune) {
+	UUID(m).Format(f, verb)
+}
+
+// MarshalText returns the canonical string representation of the unswapped
+// UUID, which encoding/json also uses for JSON.
+func (m MySQLSwapped) MarshalText() ([]byte, error) {
+	return UUID(m).MarshalText()
+}
+
+// UnmarshalText reads a textual UUID as-is like UUID.UnmarshalText(), which
+// encoding/json also uses for JSON-strings.
+func (m *MySQLSwapped) UnmarshalText(data []byte) error {
+	return (*UUID)(m).UnmarshalText(data)
+}
+
+// MSSQLUUID is a UUID which is stored as 16 bytes in the mixed-endian byte
+// order of SQL Server uniqueidentifier columns, see FromGUIDBytes(). Binary
+// values are converted in both directions so that the bytes in the database
+// match the string form of the UUID:
+//
+//	db.Exec("INSERT INTO t (id) VALUES (@p1)", uuid.MSSQLUUID(u))
+//	row.Scan((*uuid.MSSQLUUID)(&u))
+//
+// Formatting and text or JSON marshalling are unaffected by the GUID byte
+// order and give the same string as UUID.
+type MSSQLUUID UUID
+
+// Scan scans a uuid from the given value like UUID.Scan(), converting it
+// from the GUID byte order if it is a binary UUID. Textual UUIDs are read
+// as-is.
+// If scanning fails the state of the UUID is undetermined.
+func (m *MSSQLUUID) Scan(val interface{}) error {
+	if b, ok := val.([]byte); ok && len(b) == 16 {
+		u, err := FromGUIDBytes(b)
+
+		*m = MSSQLUUID(u)
+
+		return err
+	}
+
+	return (*UUID)(m).Scan(val)
+}
+
+// Value gives the database driver representation of the UUID as 16 bytes
+// in the GUID byte order.
+func (m MSSQLUUID) Value() (driver.Value, error) {
+	b := UUID(m).GUIDBytes()
+
+	return b[:], nil
+}
+
+// String returns the canonical string representation of the UUID.
+func (m MSSQLUUID) String() string {
+	return UUID(m).String()
+}
+
+// Format formats the UUID like UUID.Format().
+func (m MSSQLUUID) Format(f fmt.State, verb rune) {
+	UUID(m).Format(f, verb)
+}
+
+// MarshalText returns the canonical string representation of the UUID, not
+// the GUID byte order, which encoding/json also uses for JSON.
+func (m MSSQLUUID) MarshalText() ([]byte, error) {
+	return UUID(m).MarshalText()
+}
+
+// UnmarshalText reads a textual UUID like UUID.UnmarshalText(), which
+// encoding/json also uses for JSON-strings.
+func (m *MSSQLUUID) UnmarshalText(data []byte) error {
+	return (*UUID)(m).UnmarshalText(data)
 }
@@ -1,6 +1,9 @@
 package uuid
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"reflect"
 	"testing"
 )
@@ -38,6 +41,63 @@ func TestUUIDScanByte(t *testing.T) {
 	}
 }
 
+func TestUUIDScanBinary(t *testing.T) {
+	u := UUID{}
+	v := MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+
+	err := u.Scan(v[:])
+	if err != nil {
+		t.Error("UUID.Scan failed on binary UUID: " + err.Error())
+	}
+
+	if u != v {
+		t.Error("UUID.Scan failed to properly read binary UUID, got " + u.String())
+	}
+}
+
+type testStringer string
+
+func (s testStringer) String() string {
+	return string(s)
+}
+
+func TestUUIDScanTypes(t *testing.T) {
+	v := MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+
+	list := []interface{}{
+		"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11",
+		[]byte("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"),
+		v[:],
+		[16]byte(v),
+		v,
+		&v,
+		testStringer("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"),
+	}
+
+	for _, val := range list {
+		u := UUID{}
+
+		err := u.Scan(val)
+		if err != nil {
+			t.Errorf("UUID.Scan failed on %T: %s", val, err.Error())
+		} else if u != v {
+			t.Errorf("UUID.Scan(%T) returned %s, expected %s", val, u, v)
+		}
+	}
+
+	u := UUID{}
+
+	if err := u.Scan(testStringer("invalid")); err == nil {
+		t.Error("Expected UUID.Scan to fail on invalid fmt.Stringer, did not fail")
+	}
+
+	for _, val := range []interface{}{(*UUID)(nil), 1.5, true, [15]byte{}} {
+		if _, ok := u.Scan(val).(*ErrInvalidType); !ok {
+			t.Errorf("Expected UUID.Scan to fail with ErrInvalidType on %T", val)
+		}
+	}
+}
+
 func TestUUIDScanInt(t *testing.T) {
 	u := UUID{}
 
@@ -53,6 +113,19 @@ func TestUUIDScanInt(t *testing.T) {
 	}
 }
 
+func TestErrInvalidType(t *testing.T) {
+	list := map[string]error{
+		"uuid Scan(): invalid type 'int'.":   &ErrInvalidType{reflect.TypeOf(1)},
+		"uuid Scan(): invalid type '<nil>'.": &ErrInvalidType{},
+	}
+
+	for s, e := range list {
+		if e.Error() != s {
+			t.Errorf("ErrInvalidType.Error() returned %s, expected %s", e.Error(), s)
+		}
+	}
+}
+
 func TestUUIDScanNil(t *testing.T) {
 	u := UUID{}
 
@@ -122,6 +195,20 @@ func TestNullUUIDScanByte(t *testing.T) {
 	}
 }
 
+func TestNullUUIDScanBinary(t *testing.T) {
+	u := NullUUID{}
+	v := MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+
+	err := u.Scan(v[:])
+	if err != nil {
+		t.Error("NullUUID.Scan failed on binary UUID: " + err.Error())
+	}
+
+	if !u.Valid || u.UUID != v {
+		t.Error("NullUUID.Scan failed to properly read binary UUID, got " + u.UUID.String())
+	}
+}
+
 func TestNullUUIDScanInt(t *testing.T) {
 	u := NullUUID{}
 
@@ -197,6 +284,271 @@ func TestNullUUIDValue2(t *testing.T) {
 	}
 }
 
+type testRawUUID [16]byte
+
+func TestNullGeneric(t *testing.T) {
+	u := MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+	g := u.GUIDBytes()
+
+	b := Null[BinaryUUID]{Valid: true, UUID: BinaryUUID(u)}
+
+	v, err := b.Value()
+	if err != nil || !reflect.DeepEqual(v, u[:]) {
+		t.Errorf("Null[BinaryUUID].Value() returned %v, %v, expected %v", v, err, u[:])
+	}
+
+	m := Null[MSSQLUUID]{}
+
+	err = m.Scan(g[:])
+	if err != nil || !m.Valid || UUID(m.UUID) != u {
+		t.Errorf("Null[MSSQLUUID].Scan() returned %+v, %v, expected %s", m, err, u)
+	}
+
+	v, err = m.Value()
+	if err != nil || !reflect.DeepEqual(v, g[:]) {
+		t.Errorf("Null[MSSQLUUID].Value() returned %v, %v, expected %v", v, err, g[:])
+	}
+
+	/* Types without Scan and Value fall back to UUID */
+	r := Null[testRawUUID]{}
+
+	err = r.Scan("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+	if err != nil || !r.Valid || UUID(r.UUID) != u {
+		t.Errorf("Null[testRawUUID].Scan() returned %+v, %v, expected %s", r, err, u)
+	}
+
+	v, err = r.Value()
+	if err != nil || v != "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11" {
+		t.Errorf("Null[testRawUUID].Value() returned %v, %v", v, err)
+	}
+
+	err = r.Scan(12345)
+	if _, ok := err.(*ErrInvalidType); !ok || r.Valid {
+		t.Errorf("Null[testRawUUID].Scan() returned %+v, %v, expected ErrInvalidType", r, err)
+	}
+
+	err = r.Scan(nil)
+	if err != nil || r.Valid || r.UUID != (testRawUUID{}) {
+		t.Errorf("Null[testRawUUID].Scan(nil) returned %+v, %v", r, err)
+	}
+}
+
+func TestBinaryUUIDValue(t *testing.T) {
+	u := MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+
+	v, err := BinaryUUID(u).Value()
+	if err != nil {
+		t.Error("err is set")
+	}
+
+	if b, ok := v.([]byte); ok {
+		if !bytes.Equal(b, u[:]) {
+			t.Errorf("expected %x, got %x.", u[:], b)
+		}
+	} else {
+		t.Error("expected []byte, got " + reflect.TypeOf(v).String())
+	}
+}
+
+func TestBinaryUUIDScan(t *testing.T) {
+	u := MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+
+	for _, v := range []interface{}{u[:], "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", []byte("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")} {
+		b := BinaryUUID{}
+
+		err := b.Scan(v)
+		if err != nil {
+			t.Errorf("BinaryUUID.Scan failed on %v: %s", v, err.Error())
+		}
+
+		if UUID(b) != u {
+			t.Errorf("BinaryUUID.Scan failed to properly read %v, got %s", v, UUID(b).String())
+		}
+	}
+
+	b := BinaryUUID{}
+
+	if _, ok := b.Scan(nil).(*ErrInvalidType); !ok {
+		t.Error("Expected BinaryUUID.Scan to fail with ErrInvalidType on nil")
+	}
+}
+
+func TestSQLTypesMarshal(t *testing.T) {
+	u := MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+
+	/* Value is the UUID converted to the type, Ptr points to a zero value of it */
+	list := []struct {
+		Value interface{}
+		Ptr   interface{}
+	}{
+		{BinaryUUID(u), new(BinaryUUID)},
+		{MySQLSwapped(u), new(MySQLSwapped)},
+		{MSSQLUUID(u), new(MSSQLUUID)},
+	}
+
+	for _, v := range list {
+		b, err := json.Marshal(struct{ ID interface{} }{v.Value})
+		if err != nil || string(b) != `{"ID":"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"}` {
+			t.Errorf("json.Marshal(%T) returned %s, %v", v.Value, b, err)
+		}
+
+		err = json.Unmarshal([]byte(`"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"`), v.Ptr)
+		if r := reflect.ValueOf(v.Ptr).Elem().Interface(); err != nil || r != v.Value {
+			t.Errorf("json.Unmarshal() into %T returned %v, %v, expected %v", v.Ptr, r, err, v.Value)
+		}
+
+		for f, e := range map[string]string{
+			"%v":  "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11",
+			"%x":  "a0eebc999c0b4ef8bb6d6bb9bd380a11",
+			"%+v": "{A0EEBC99-9C0B-4EF8-BB6D-6BB9BD380A11}",
+		} {
+			if r := fmt.Sprintf(f, v.Value); r != e {
+				t.Errorf("fmt.Sprintf(%s, %T) returned %s, expected %s", f, v.Value, r, e)
+			}
+		}
+	}
+}
+
+func TestNullBinaryUUIDValue(t *testing.T) {
+	u := MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+
+	v, err := NullBinaryUUID{Valid: true, UUID: BinaryUUID(u)}.Value()
+	if err != nil {
+		t.Error("err is set")
+	}
+
+	if b, ok := v.([]byte); !ok || !bytes.Equal(b, u[:]) {
+		t.Errorf("expected %x, got %v.", u[:], v)
+	}
+
+	v, err = NullBinaryUUID{UUID: BinaryUUID(u)}.Value()
+	if err != nil {
+		t.Error("err is set")
+	}
+
+	if v != nil {
+		t.Error("expected nil, got " + reflect.TypeOf(v).String())
+	}
+}
+
+func TestNullBinaryUUIDScan(t *testing.T) {
+	u := MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+	nb := NullBinaryUUID{}
+
+	err := nb.Scan(u[:])
+	if err != nil {
+		t.Error("NullBinaryUUID.Scan failed on binary UUID: " + err.Error())
+	}
+
+	if !nb.Valid || UUID(nb.UUID) != u {
+		t.Error("NullBinaryUUID.Scan failed to properly read binary UUID, got " + UUID(nb.UUID).String())
+	}
+
+	err = nb.Scan(nil)
+	if err != nil {
+		t.Error("NullBinaryUUID.Scan failed on nil: " + err.Error())
+	}
+
+	if nb.Valid {
+		t.Error("NullBinaryUUID.Scan failed to set Valid to false for nil.")
+	}
+}
+
+func TestNullBinaryUUIDJSON(t *testing.T) {
+	u := MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+
+	b, err := json.Marshal([]NullBinaryUUID{{Valid: true, UUID: BinaryUUID(u)}, {}})
+	if err != nil {
+		t.Fatalf("json.Marshal() failed: %s", err.Error())
+	}
+
+	if string(b) != `["a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11",null]` {
+		t.Errorf("json.Marshal() returned %s", b)
+	}
+}
+
+func TestMySQLSwappedValue(t *testing.T) {
+	/* Example from the MySQL documentation of UUID_TO_BIN() */
+	u := MustFromString("6ccd780c-baba-1026-9564-5b8c656024db")
+
+	v, err := MySQLSwapped(u).Value()
+	if err != nil {
+		t.Error("err is set")
+	}
+
+	if b, ok := v.([]byte); !ok || fmt.Sprintf("%X", b) != "1026BABA6CCD780C95645B8C656024DB" {
+		t.Errorf("expected 1026BABA6CCD780C95645B8C656024DB, got %v.", v)
+	}
+}
+
+func TestMySQLSwappedScan(t *testing.T) {
+	u := MustFromString("6ccd780c-baba-1026-9564-5b8c656024db")
+	swapped := []byte{0x10, 0x26, 0xba, 0xba, 0x6c, 0xcd, 0x78, 0x0c, 0x95, 0x64, 0x5b, 0x8c, 0x65, 0x60, 0x24, 0xdb}
+
+	for _, v := range []interface{}{swapped, "6ccd780c-baba-1026-9564-5b8c656024db", []byte("6ccd780c-baba-1026-9564-5b8c656024db")} {
+		m := MySQLSwapped{}
+
+		err := m.Scan(v)
+		if err != nil {
+			t.Errorf("MySQLSwapped.Scan failed on %v: %s", v, err.Error())
+		}
+
+		if UUID(m) != u {
+			t.Errorf("MySQLSwapped.Scan failed to properly read %v, got %s", v, UUID(m).String())
+		}
+	}
+
+	m := MySQLSwapped(u)
+
+	v, err := m.Value()
+	if err != nil {
+		panic(err)
+	}
+
+	m = MySQLSwapped{}
+
+	if err := m.Scan(v); err != nil || UUID(m) != u {
+		t.Errorf("MySQLSwapped did not round-trip '%s', got '%s'", u.String(), UUID(m).String())
+	}
+}
+
+func TestMSSQLUUIDValue(t *testing.T) {
+	u := MustFromString("00112233-4455-6677-8899-aabbccddeeff")
+
+	v, err := MSSQLUUID(u).Value()
+	if err != nil {
+		t.Error("err is set")
+	}
+
+	if b, ok := v.([]byte); !ok || fmt.Sprintf("%x", b) != "33221100554477668899aabbccddeeff" {
+		t.Errorf("expected 33221100554477668899aabbccddeeff, got %v.", v)
+	}
+}
+
+func TestMSSQLUUIDScan(t *testing.T) {
+	u := MustFromString("00112233-4455-6677-8899-aabbccddeeff")
+	guid := []byte{0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}
+
+	for _, v := range []interface{}{guid, "00112233-4455-6677-8899-aabbccddeeff", []byte("00112233-4455-6677-8899-aabbccddeeff")} {
+		m := MSSQLUUID{}
+
+		err := m.Scan(v)
+		if err != nil {
+			t.Errorf("MSSQLUUID.Scan failed on %v: %s", v, err.Error())
+		}
+
+		if UUID(m) != u {
+			t.Errorf("MSSQLUUID.Scan failed to properly read %v, got %s", v, UUID(m).String())
+		}
+	}
+
+	m := MSSQLUUID{}
+
+	if _, ok := m.Scan(12345).(*ErrInvalidType); !ok {
+		t.Error("Expected MSSQLUUID.Scan to fail with ErrInvalidType on integer")
+	}
+}
+
 func BenchmarkUUIDValue(b *testing.B) {
 	u, err := FromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
 	if err != nil {
@@ -279,3 +631,11 @@ func BenchmarkNullUUIDScanNil(b *testing.B) {
 		_ = nu.Scan(nil)
 	}
 }
+
+func BenchmarkBinaryUUIDValue(b *testing.B) {
+	u := BinaryUUID(MustFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"))
+
+	for i := 0; i < b.N; i++ {
+		u.Value()
+	}
+}
@@ -1,8 +1,10 @@
 package uuid
 
 import (
+	"crypto/rand"
 	"fmt"
 	"testing"
+	"time"
 )
 
 var (
@@ -181,6 +183,85 @@ func TestSetString(t *testing.T) {
 	}
 }
 
+func TestFromBytes(t *testing.T) {
+	v := MustFromString(testStringUUID)
+
+	u, err := FromBytes(v[:])
+	if err != nil {
+		t.Fatalf("FromBytes() failed: %s", err.Error())
+	}
+
+	if u != v {
+		t.Errorf("FromBytes() returned '%s', expected '%s'", u.String(), v.String())
+	}
+
+	_, err = FromBytes(testByteUUID)
+	if e, ok := err.(*ErrInvalidLength); !ok || e.Length != len(testByteUUID) {
+		t.Errorf("FromBytes() on text expected ErrInvalidLength, got %v", err)
+	}
+}
+
+func TestSetBytes(t *testing.T) {
+	v := MustFromString(testStringUUID)
+	u := UUID{}
+
+	err := u.SetBytes(v[:])
+	if err != nil || u != v {
+		t.Errorf("SetBytes() returned '%s', %v, expected '%s'", u.String(), err, v.String())
+	}
+
+	err = u.SetBytes(testByteUUID)
+	if _, ok := err.(*ErrInvalidLength); !ok {
+		t.Errorf("SetBytes() on text expected ErrInvalidLength, got %v", err)
+	}
+
+	if u != v {
+		t.Errorf("SetBytes() modified the UUID on error")
+	}
+}
+
+func TestGUIDBytes(t *testing.T) {
+	u := MustFromString("00112233-4455-6677-8899-aabbccddeeff")
+	guid := []byte{0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}
+
+	if b := u.GUIDBytes(); string(b[:]) != string(guid) {
+		t.Errorf("GUIDBytes() returned %x, expected %x", b, guid)
+	}
+
+	v, err := FromGUIDBytes(guid)
+	if err != nil {
+		t.Fatalf("FromGUIDBytes() failed: %s", err.Error())
+	}
+
+	if v != u {
+		t.Errorf("FromGUIDBytes() returned '%s', expected '%s'", v.String(), u.String())
+	}
+
+	_, err = FromGUIDBytes(guid[:15])
+	if _, ok := err.(*ErrInvalidLength); !ok {
+		t.Errorf("FromGUIDBytes() on 15 bytes expected ErrInvalidLength, got %v", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	v := MustFromString(testStringUUID)
+
+	for _, b := range [][]byte{v[:], testByteUUID, []byte("a0eebc999c0b4ef8bb6d6bb9bd380a11")} {
+		u, err := Parse(b)
+		if err != nil {
+			t.Errorf("Parse(%x) failed: %s", b, err.Error())
+		} else if u != v {
+			t.Errorf("Parse(%x) returned '%s', expected '%s'", b, u.String(), v.String())
+		}
+	}
+
+	for _, b := range [][]byte{nil, v[:15], append(v[:], 0)} {
+		if _, err := Parse(b); err == nil {
+			t.Errorf("Parse(%x) did not fail", b)
+		}
+	}
+}
+
 func TestMaybeFromString(t *testing.T) {
 	for i, v := range testMixed {
 		u := MaybeFromString(i)
@@ -233,6 +314,440 @@ func TestV4(t *testing.T) {
 	}
 }
 
+func TestV4Allocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocations are not accurate with the race detector")
+	}
+
+	n := testing.AllocsPerRun(100, func() {
+		V4()
+		V7()
+	})
+
+	if n != 0 {
+		t.Errorf("V4() and V7() allocated %f times, expected 0", n)
+	}
+}
+
+func TestV2(t *testing.T) {
+	for _, d := range []Domain{DomainPerson, DomainGroup, DomainOrg} {
+		u, err := V2(d, 1000)
+		if err != nil {
+			panic(err)
+		}
+
+		if u.Version() != 2 {
+			t.Errorf("UUID generated from V2() does not have the version byte set to 2: '%s'.", u.String())
+		}
+
+		if u[8]&0xC0 != 0x80 {
+			t.Errorf("UUID generated from V2() does not have the 9th byte beginning with 8, 9, A or B: '%s'.", u.String())
+		}
+
+		if u.Domain() != d {
+			t.Errorf("UUID generated from V2() has domain %s, expected %s: '%s'.", u.Domain(), d, u.String())
+		}
+
+		if u.ID() != 1000 {
+			t.Errorf("UUID generated from V2() has ID %d, expected 1000: '%s'.", u.ID(), u.String())
+		}
+	}
+}
+
+func TestDomainString(t *testing.T) {
+	list := map[Domain]string{
+		DomainPerson: "Person",
+		DomainGroup:  "Group",
+		DomainOrg:    "Org",
+		Domain(17):   "Domain(17)",
+	}
+
+	for d, v := range list {
+		if d.String() != v {
+			t.Errorf("Domain(%d).String() returned '%s', expected '%s'", byte(d), d.String(), v)
+		}
+	}
+}
+
+func TestV4Batch(t *testing.T) {
+	dst := make([]UUID, 1000)
+	seen := make(map[UUID]bool, len(dst))
+
+	err := V4Batch(dst)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, u := range dst {
+		if u[6]&0xf0 != 0x40 {
+			t.Errorf("UUID generated from V4Batch() does not have the version byte set to 4: '%s'.", u.String())
+		}
+
+		if u[8]&0xC0 != 0x80 {
+			t.Errorf("UUID generated from V4Batch() does not have the 9th byte beginning with 8, 9, A or B: '%s'.", u.String())
+		}
+
+		if seen[u] {
+			t.Errorf("V4Batch() generated duplicate UUID '%s'.", u.String())
+		}
+
+		seen[u] = true
+	}
+}
+
+func TestV1(t *testing.T) {
+	u, err := V1()
+	if err != nil {
+		panic(err)
+	}
+
+	if u.Version() != 1 {
+		t.Errorf("UUID generated from V1() does not have the version byte set to 1: '%s'.", u.String())
+	}
+
+	if u[8]&0xC0 != 0x80 {
+		t.Errorf("UUID generated from V1() does not have the 9th byte beginning with 8, 9, A or B: '%s'.", u.String())
+	}
+
+	if u[10]&0x01 != 0x01 {
+		t.Errorf("UUID generated from V1() does not have the multicast bit set in the node ID: '%s'.", u.String())
+	}
+}
+
+func TestV1Unique(t *testing.T) {
+	const n = 10000
+
+	seen := make(map[UUID]bool, 4*n)
+	ch := make(chan UUID, 4*n)
+
+	for g := 0; g < 4; g++ {
+		go func() {
+			for i := 0; i < n; i++ {
+				u, err := V1()
+				if err != nil {
+					panic(err)
+				}
+
+				ch <- u
+			}
+		}()
+	}
+
+	for i := 0; i < 4*n; i++ {
+		u := <-ch
+
+		if seen[u] {
+			t.Fatalf("V1() generated duplicate UUID '%s'.", u.String())
+		}
+
+		seen[u] = true
+	}
+}
+
+func TestV3(t *testing.T) {
+	list := []struct {
+		Namespace UUID
+		Name      string
+		Expected  string
+	}{
+		{NamespaceDNS, "www.example.com", "5df41881-3aed-3515-88a7-2f4a814cf09e"},
+		{NamespaceDNS, "python.org", "6fa459ea-ee8a-3ca4-894e-db77e160355e"},
+		{NamespaceURL, "http://www.example.com/", "556cf76b-3b36-3ae6-85f9-50424b369b50"},
+		{NamespaceOID, "1.3.6.1", "dd1a1cef-13d5-368a-ad82-eca71acd4cd1"},
+		{NamespaceX500, "cn=John Doe", "8f186217-0963-3551-9dcd-d4fdc1841c63"},
+	}
+
+	for _, v := range list {
+		u := V3(v.Namespace, []byte(v.Name))
+
+		if u.String() != v.Expected {
+			t.Errorf("V3(%s, %s) returned '%s', expected '%s'", v.Namespace.String(), v.Name, u.String(), v.Expected)
+		}
+	}
+}
+
+func TestV5(t *testing.T) {
+	list := []struct {
+		Namespace UUID
+		Name      string
+		Expected  string
+	}{
+		{NamespaceDNS, "www.example.com", "2ed6657d-e927-568b-95e1-2665a8aea6a2"},
+		{NamespaceDNS, "python.org", "886313e1-3b8a-5372-9b90-0c9aee199e5d"},
+		{NamespaceURL, "http://www.example.com/", "fcde3c85-2270-590f-9e7c-ee003d65e0e2"},
+		{NamespaceOID, "1.3.6.1", "1447fa61-5277-5fef-a9b3-fbc6e44f4af3"},
+		{NamespaceX500, "cn=John Doe", "6b28d549-d26e-5bfc-ae5e-9a39af63dc3f"},
+	}
+
+	for _, v := range list {
+		u := V5(v.Namespace, []byte(v.Name))
+
+		if u.String() != v.Expected {
+			t.Errorf("V5(%s, %s) returned '%s', expected '%s'", v.Namespace.String(), v.Name, u.String(), v.Expected)
+		}
+	}
+}
+
+func TestV6(t *testing.T) {
+	prev, err := V6()
+	if err != nil {
+		panic(err)
+	}
+
+	for i := 0; i < 1000; i++ {
+		u, err := V6()
+		if err != nil {
+			panic(err)
+		}
+
+		if u.Version() != 6 {
+			t.Fatalf("UUID generated from V6() does not have the version byte set to 6: '%s'.", u.String())
+		}
+
+		if u[8]&0xC0 != 0x80 {
+			t.Fatalf("UUID generated from V6() does not have the 9th byte beginning with 8, 9, A or B: '%s'.", u.String())
+		}
+
+		if u == prev {
+			t.Fatalf("V6() generated duplicate UUID '%s'.", u.String())
+		}
+
+		prev = u
+	}
+}
+
+func TestToV6(t *testing.T) {
+	/* Test vectors from RFC 9562 appendix A */
+	u, err := MustFromString("c232ab00-9414-11ec-b3c8-9f6bdeced846").ToV6()
+	if err != nil {
+		t.Fatalf("ToV6() failed: %s", err.Error())
+	}
+
+	if u.String() != "1ec9414c-232a-6b00-b3c8-9f6bdeced846" {
+		t.Errorf("ToV6() returned '%s', expected '1ec9414c-232a-6b00-b3c8-9f6bdeced846'", u.String())
+	}
+
+	_, err = MustFromString("1ec9414c-232a-6b00-b3c8-9f6bdeced846").ToV6()
+	if e, ok := err.(*ErrWrongVersion); !ok || e.Version != 6 || e.Expected != 1 {
+		t.Errorf("ToV6() on V6 UUID expected ErrWrongVersion, got %v", err)
+	}
+}
+
+func TestToV1(t *testing.T) {
+	/* Test vectors from RFC 9562 appendix A */
+	u, err := MustFromString("1ec9414c-232a-6b00-b3c8-9f6bdeced846").ToV1()
+	if err != nil {
+		t.Fatalf("ToV1() failed: %s", err.Error())
+	}
+
+	if u.String() != "c232ab00-9414-11ec-b3c8-9f6bdeced846" {
+		t.Errorf("ToV1() returned '%s', expected 'c232ab00-9414-11ec-b3c8-9f6bdeced846'", u.String())
+	}
+
+	_, err = MustFromString("ebd435d3-63eb-43c6-8e92-342238da6b58").ToV1()
+	if e, ok := err.(*ErrWrongVersion); !ok || e.Version != 4 || e.Expected != 6 {
+		t.Errorf("ToV1() on V4 UUID expected ErrWrongVersion, got %v", err)
+	}
+}
+
+func TestV1V6RoundTrip(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		u, err := V1()
+		if err != nil {
+			panic(err)
+		}
+
+		v6, err := u.ToV6()
+		if err != nil {
+			t.Fatalf("ToV6(%s) failed: %s", u.String(), err.Error())
+		}
+
+		v1, err := v6.ToV1()
+		if err != nil {
+			t.Fatalf("ToV1(%s) failed: %s", v6.String(), err.Error())
+		}
+
+		if v1 != u {
+			t.Fatalf("V1 -> V6 -> V1 returned '%s', expected '%s'", v1.String(), u.String())
+		}
+	}
+}
+
+func TestV8(t *testing.T) {
+	list := []struct {
+		Payload  [16]byte
+		Expected string
+	}{
+		{[16]byte{}, "00000000-0000-8000-8000-000000000000"},
+		{[16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "ffffffff-ffff-8fff-bfff-ffffffffffff"},
+		{[16]byte{0x32, 0x0c, 0x3d, 0x4d, 0xcc, 0x00, 0x00, 0x75, 0x0b, 0xcd, 0xe0, 0x86, 0x12, 0x06, 0x48, 0x1c}, "320c3d4d-cc00-8075-8bcd-e0861206481c"},
+	}
+
+	for _, v := range list {
+		u := V8(v.Payload)
+
+		if u.String() != v.Expected {
+			t.Errorf("V8(%x) returned '%s', expected '%s'", v.Payload, u.String(), v.Expected)
+		}
+
+		c := u.CustomBits()
+		p := v.Payload
+		p[6] &= 0x0F
+		p[8] &= 0x3F
+
+		if c != p {
+			t.Errorf("CustomBits(%s) returned %x, expected %x", u.String(), c, p)
+		}
+	}
+}
+
+func TestTime(t *testing.T) {
+	/* Test vectors from RFC 9562 appendix A */
+	list := []string{
+		"c232ab00-9414-11ec-b3c8-9f6bdeced846",
+		"1ec9414c-232a-6b00-b3c8-9f6bdeced846",
+		"017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+	}
+
+	for _, v := range list {
+		tm, err := MustFromString(v).Time()
+		if err != nil {
+			t.Errorf("Time(%s) failed: %s", v, err.Error())
+		} else if !tm.Equal(testRFCTime) {
+			t.Errorf("Time(%s) returned %s, expected %s", v, tm, testRFCTime)
+		}
+	}
+
+	tm, err := MustFromString("00000000-0000-1000-8000-000000000000").Time()
+	if err != nil {
+		t.Errorf("Time() failed on zero V1 timestamp: %s", err.Error())
+	} else if !tm.Equal(time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Time() on zero V1 timestamp returned %s, expected start of Gregorian calendar", tm)
+	}
+
+	for _, v := range []string{
+		"000001f5-9414-21ec-b301-9f6bdeced846",
+		"5df41881-3aed-3515-88a7-2f4a814cf09e",
+		"919108f7-52d1-4320-9bac-f847db4148a8",
+		"2ed6657d-e927-568b-95e1-2665a8aea6a2",
+		"320c3d4d-cc00-875b-8ec9-32d5f69181c0",
+	} {
+		u := MustFromString(v)
+
+		_, err := u.Time()
+		if e, ok := err.(*ErrNoTime); !ok || e.Version != u.Version() {
+			t.Errorf("Time(%s) expected ErrNoTime, got %v", v, err)
+		}
+	}
+}
+
+func TestClockSequenceNodeID(t *testing.T) {
+	for _, v := range []string{
+		"c232ab00-9414-11ec-b3c8-9f6bdeced846",
+		"1ec9414c-232a-6b00-b3c8-9f6bdeced846",
+	} {
+		u := MustFromString(v)
+
+		if u.ClockSequence() != 0x33c8 {
+			t.Errorf("ClockSequence(%s) returned %x, expected 33c8", v, u.ClockSequence())
+		}
+
+		if u.NodeID() != [6]byte{0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46} {
+			t.Errorf("NodeID(%s) returned %x, expected 9f6bdeced846", v, u.NodeID())
+		}
+	}
+}
+
+func TestV7(t *testing.T) {
+	before := uint64(time.Now().UnixMilli())
+
+	u, err := V7()
+	if err != nil {
+		panic(err)
+	}
+
+	after := uint64(time.Now().UnixMilli())
+
+	if u.Version() != 7 {
+		t.Errorf("UUID generated from V7() does not have the version byte set to 7: '%s'.", u.String())
+	}
+
+	if u[8]&0xC0 != 0x80 {
+		t.Errorf("UUID generated from V7() does not have the 9th byte beginning with 8, 9, A or B: '%s'.", u.String())
+	}
+
+	if ms := u.v7Time(); ms < before || ms > after {
+		t.Errorf("UUID generated from V7() has timestamp %d, expected between %d and %d: '%s'.", ms, before, after, u.String())
+	}
+}
+
+func TestV7Monotonic(t *testing.T) {
+	prev, err := V7Monotonic()
+	if err != nil {
+		panic(err)
+	}
+
+	for i := 0; i < 10000; i++ {
+		u, err := V7Monotonic()
+		if err != nil {
+			panic(err)
+		}
+
+		if u.Version() != 7 || u[8]&0xC0 != 0x80 {
+			t.Fatalf("UUID generated from V7Monotonic() has invalid version or variant: '%s'.", u.String())
+		}
+
+		if string(prev[:]) >= string(u[:]) {
+			t.Fatalf("UUID generated from V7Monotonic() '%s' does not sort after '%s'.", u.String(), prev.String())
+		}
+
+		prev = u
+	}
+}
+
+func TestIncrementV7(t *testing.T) {
+	list := map[string]string{
+		"01890a5d-ac96-774b-bcce-b302099a8057": "01890a5d-ac96-774b-bcce-b302099a8058",
+		"01890a5d-ac96-774b-bcce-b302099a80ff": "01890a5d-ac96-774b-bcce-b302099a8100",
+		"01890a5d-ac96-774b-bfff-ffffffffffff": "01890a5d-ac96-774c-8000-000000000000",
+		"01890a5d-ac96-7fff-bfff-ffffffffffff": "01890a5d-ac97-7000-8000-000000000000",
+	}
+
+	for i, v := range list {
+		u := MustFromString(i)
+
+		u.incrementV7()
+
+		if u.String() != v {
+			t.Errorf("incrementV7(%s) returned '%s', expected '%s'", i, u.String(), v)
+		}
+	}
+}
+
+func TestSetNodeID(t *testing.T) {
+	node := [6]byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55}
+
+	// Use a fresh default generator to not leak the node ID to other tests
+	defer func(g *Generator) {
+		defaultGenerator = g
+	}(defaultGenerator)
+
+	defaultGenerator = NewGenerator(nil, nil)
+
+	SetNodeID(node)
+
+	for _, f := range []func() (UUID, error){V1, V6} {
+		u, err := f()
+		if err != nil {
+			panic(err)
+		}
+
+		if string(u[10:]) != string(node[:]) {
+			t.Errorf("UUID generated from package-level generator does not have the node ID set by SetNodeID(): '%s'.", u.String())
+		}
+	}
+}
+
 func TestSetZero(t *testing.T) {
 	u, err := FromString("12345678-9abc-deff-edcb-a98765432100")
 	if err != nil {
@@ -251,11 +766,19 @@ func TestSetZero(t *testing.T) {
 }
 
 func TestVersion(t *testing.T) {
-	list := map[string]int{
+	list := map[string]Version{
 		"10a7f7c0-1011-11e5-ad77-0002a5d5c51b": 1,
+		"000001f5-9414-21ec-b301-9f6bdeced846": 2,
 		"22220da4-d863-3451-98ce-02cc7288bf9a": 3,
 		"ebd435d3-63eb-43c6-8e92-342238da6b58": 4,
 		"92ce3c5b-5c3e-51e5-8490-2a2334346357": 5,
+		"1ec9414c-232a-6b00-b3c8-9f6bdeced846": 6,
+		"017f22e2-79b0-7cc3-98c4-dc0c0c07398f": 7,
+		"320c3d4d-cc00-875b-8ec9-32d5f69181c0": 8,
+		"00000000-0000-0000-0000-000000000000": 0,
+		"ffffffff-ffff-ffff-ffff-ffffffffffff": 0,
+		"ebd435d3-63eb-43c6-0e92-342238da6b58": 0, /* NCS variant */
+		"ebd435d3-63eb-43c6-ce92-342238da6b58": 0, /* Microsoft variant */
 	}
 
 	for i, v := range list {
@@ -267,6 +790,50 @@ func TestVersion(t *testing.T) {
 	}
 }
 
+func TestVersionIsValid(t *testing.T) {
+	for v := 0; v < 16; v++ {
+		if Version(v).IsValid() != (v >= 1 && v <= 8) {
+			t.Errorf("Version(%d).IsValid() returned %t", v, Version(v).IsValid())
+		}
+	}
+}
+
+func TestVersionString(t *testing.T) {
+	list := map[Version]string{
+		0: "Version(0)",
+		4: "V4 (random)",
+		7: "V7 (Unix time-based)",
+		9: "Version(9)",
+	}
+
+	for v, s := range list {
+		if v.String() != s {
+			t.Errorf("Version(%d).String() returned '%s', expected '%s'", byte(v), v.String(), s)
+		}
+	}
+}
+
+func TestVariant(t *testing.T) {
+	list := map[string]Variant{
+		"00000000-0000-0000-0000-000000000000": VariantNCS,
+		"ebd435d3-63eb-43c6-7e92-342238da6b58": VariantNCS,
+		"ebd435d3-63eb-43c6-8e92-342238da6b58": VariantRFC9562,
+		"ebd435d3-63eb-43c6-be92-342238da6b58": VariantRFC9562,
+		"ebd435d3-63eb-43c6-ce92-342238da6b58": VariantMicrosoft,
+		"ebd435d3-63eb-43c6-de92-342238da6b58": VariantMicrosoft,
+		"ebd435d3-63eb-43c6-ee92-342238da6b58": VariantFuture,
+		"ffffffff-ffff-ffff-ffff-ffffffffffff": VariantFuture,
+	}
+
+	for i, v := range list {
+		u := MustFromString(i)
+
+		if u.Variant() != v {
+			t.Errorf("Variant(%s) returned %s, expected %s", i, u.Variant(), v)
+		}
+	}
+}
+
 func BenchmarkFromString(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		FromString("12345678-9abc-deff-edcb-a98765432100")
@@ -302,7 +869,7 @@ func BenchmarkString(b *testing.B) {
 	}
 
 	for i := 0; i < b.N; i++ {
-		u.String()
+		_ = u.String()
 	}
 }
 
@@ -312,6 +879,81 @@ func BenchmarkV4(b *testing.B) {
 	}
 }
 
+func BenchmarkV1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		V1()
+	}
+}
+
+func BenchmarkV3(b *testing.B) {
+	name := []byte("www.example.com")
+
+	for i := 0; i < b.N; i++ {
+		V3(NamespaceDNS, name)
+	}
+}
+
+func BenchmarkV5(b *testing.B) {
+	name := []byte("www.example.com")
+
+	for i := 0; i < b.N; i++ {
+		V5(NamespaceDNS, name)
+	}
+}
+
+func BenchmarkV4Parallel(b *testing.B) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			V4()
+		}
+	})
+}
+
+// BenchmarkV4Batch reports the time per generated UUID.
+func BenchmarkV4Batch(b *testing.B) {
+	dst := make([]UUID, 1024)
+
+	for i := 0; i < b.N; i += len(dst) {
+		V4Batch(dst)
+	}
+}
+
+func BenchmarkV4Buffered(b *testing.B) {
+	g := NewGenerator(NewBufferedReader(rand.Reader), nil)
+
+	for i := 0; i < b.N; i++ {
+		g.NewV4()
+	}
+}
+
+func BenchmarkV4BufferedParallel(b *testing.B) {
+	g := NewGenerator(NewBufferedReader(rand.Reader), nil)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			g.NewV4()
+		}
+	})
+}
+
+func BenchmarkV6(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		V6()
+	}
+}
+
+func BenchmarkV7(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		V7()
+	}
+}
+
+func BenchmarkV7Monotonic(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		V7Monotonic()
+	}
+}
+
 func BenchmarkMaybeFromStringOk(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		MaybeFromString("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")